@@ -0,0 +1,430 @@
+package gitlab
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// defaultMaxArtifactBytes is the default amount of artifact file content returned from a single tool call
+const defaultMaxArtifactBytes = 50000
+
+// maxArtifactsArchiveBytes is the largest artifacts archive downloaded to list its files.
+// The archive is held in memory, so larger ones are only described.
+const maxArtifactsArchiveBytes = 20 << 20
+
+// binarySniffBytes is how much of a file is checked for NUL bytes when telling text from binary, as git does
+const binarySniffBytes = 8000
+
+// jobArtifact is one of the artifacts of a job, such as the archive, its metadata or a report
+type jobArtifact struct {
+	FileType   string `json:"file_type"`
+	Filename   string `json:"filename"`
+	Size       int    `json:"size"`
+	FileFormat string `json:"file_format,omitempty"`
+}
+
+// artifactEntry is a file in an artifacts archive
+type artifactEntry struct {
+	Path string `json:"path"`
+	Size uint64 `json:"size"`
+}
+
+// jobArtifacts is the response of list_job_artifacts
+type jobArtifacts struct {
+	JobID     int           `json:"job_id"`
+	Job       string        `json:"job"`
+	Ref       string        `json:"ref"`
+	Status    string        `json:"status"`
+	ExpireAt  *time.Time    `json:"expire_at,omitempty"`
+	Artifacts []jobArtifact `json:"artifacts"`
+	// Files lists the archive's files when they were asked for and the archive is small enough to download
+	Files []artifactEntry `json:"files,omitempty"`
+	// FilesUnavailable tells why the archive's files are not listed although they were asked for
+	FilesUnavailable string `json:"files_unavailable,omitempty"`
+}
+
+// listArchiveFiles returns the files of a zip archive, leaving out directories
+func listArchiveFiles(data []byte) ([]artifactEntry, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	files := make([]artifactEntry, 0, len(reader.File))
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		files = append(files, artifactEntry{Path: file.Name, Size: file.UncompressedSize64})
+	}
+	return files, nil
+}
+
+// artifactContent is a file read from an artifacts archive
+type artifactContent struct {
+	Path        string `json:"path"`
+	Size        int    `json:"size"`
+	ContentType string `json:"content_type"`
+	// Binary files have no content, only their size and detected type
+	Binary    bool   `json:"binary"`
+	Content   string `json:"content,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+// cappedBuffer keeps the first limit bytes written to it and only counts the rest,
+// so reading a large artifact file never holds more than limit bytes in memory
+type cappedBuffer struct {
+	buf   bytes.Buffer
+	limit int
+	total int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	b.total += len(p)
+	if room := b.limit - b.buf.Len(); room > 0 {
+		if len(p) > room {
+			b.buf.Write(p[:room])
+		} else {
+			b.buf.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+// downloadArtifactFile downloads an artifact file from the given API path, keeping only enough of it to return maxBytes of text.
+// The client's download methods buffer the whole file, which can be hundreds of megabytes for a log or a bundle.
+// It returns the start of the file and the size of the whole file.
+func downloadArtifactFile(client *gitlab.Client, path string, opt interface{}, maxBytes int) ([]byte, int, error) {
+	req, err := client.NewRequest(http.MethodGet, path, opt, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	limit := maxBytes
+	if limit < binarySniffBytes {
+		limit = binarySniffBytes
+	}
+	// a little past maxBytes so a rune cut at the limit can be told from invalid UTF-8
+	file := &cappedBuffer{limit: limit + utf8.UTFMax}
+	if _, err := client.Do(req, file); err != nil {
+		return nil, 0, err
+	}
+	return file.buf.Bytes(), file.total, nil
+}
+
+// readArtifactContent describes an artifact file of the given size from the start of it, and returns its content when it is text, cut to maxBytes.
+// A file is binary when it has a NUL byte near its start or the returned content is not valid UTF-8.
+func readArtifactContent(path string, data []byte, size int, maxBytes int) artifactContent {
+	content := artifactContent{
+		Path:        path,
+		Size:        size,
+		ContentType: http.DetectContentType(data),
+	}
+
+	sniff := data
+	if len(sniff) > binarySniffBytes {
+		sniff = sniff[:binarySniffBytes]
+	}
+	if bytes.IndexByte(sniff, 0) >= 0 {
+		content.Binary = true
+		return content
+	}
+
+	end := len(data)
+	if end > maxBytes {
+		// cut at the start of a rune so the content stays valid UTF-8
+		end = maxBytes
+		for end > 0 && !utf8.RuneStart(data[end]) {
+			end--
+		}
+	}
+	// only the returned part is checked, so a stray byte further into a large log does not hide all of it
+	if !utf8.Valid(data[:end]) {
+		content.Binary = true
+		return content
+	}
+	content.Content = string(data[:end])
+	content.Truncated = end < size
+	return content
+}
+
+// withMaxArtifactBytes adds the optional max_bytes parameter to a tool
+func withMaxArtifactBytes(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return mcp.WithNumber("max_bytes",
+		mcp.Description(t("PARAM_MAX_ARTIFACT_BYTES_DESCRIPTION", fmt.Sprintf("Maximum size of file content to return (default %d)", defaultMaxArtifactBytes))),
+		mcp.Min(0),
+	)
+}
+
+// optionalMaxArtifactBytes returns the max_bytes parameter from the request, falling back to the default
+func optionalMaxArtifactBytes(r mcp.CallToolRequest) (int, error) {
+	maxBytes, err := OptionalInt(r, "max_bytes")
+	if err != nil {
+		return 0, err
+	}
+	if maxBytes <= 0 {
+		return defaultMaxArtifactBytes, nil
+	}
+	return maxBytes, nil
+}
+
+// ListJobArtifacts returns a tool for listing the artifacts of a job
+func ListJobArtifacts(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_job_artifacts",
+		mcp.WithDescription(t("TOOL_LIST_JOB_ARTIFACTS_DESCRIPTION", "List the artifacts of a job, such as its archive and reports, and optionally the files in the artifacts archive")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("job_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_JOB_ID_DESCRIPTION", "The ID of the job")),
+		),
+		mcp.WithBoolean("include_files",
+			mcp.Description(t("PARAM_ARTIFACTS_INCLUDE_FILES_DESCRIPTION", fmt.Sprintf("List the files in the artifacts archive. Archives over %d bytes are not listed", maxArtifactsArchiveBytes))),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		jobID, err := RequiredInt(r, "job_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		includeFiles, err := OptionalParam[bool](r, "include_files")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pid := fmt.Sprintf("%s/%s", namespace, project)
+
+		job, _, err := client.Jobs.GetJob(pid, jobID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get job: %w", err).Error()), nil
+		}
+
+		result := jobArtifacts{
+			JobID:     job.ID,
+			Job:       job.Name,
+			Ref:       job.Ref,
+			Status:    job.Status,
+			ExpireAt:  job.ArtifactsExpireAt,
+			Artifacts: make([]jobArtifact, 0, len(job.Artifacts)),
+		}
+		for _, artifact := range job.Artifacts {
+			result.Artifacts = append(result.Artifacts, jobArtifact{
+				FileType:   artifact.FileType,
+				Filename:   artifact.Filename,
+				Size:       artifact.Size,
+				FileFormat: artifact.FileFormat,
+			})
+		}
+
+		if includeFiles {
+			switch {
+			case job.ArtifactsFile.Filename == "":
+				result.FilesUnavailable = "the job has no artifacts archive"
+			case job.ArtifactsFile.Size > maxArtifactsArchiveBytes:
+				result.FilesUnavailable = fmt.Sprintf("the artifacts archive is %d bytes, over the %d bytes that are listed", job.ArtifactsFile.Size, maxArtifactsArchiveBytes)
+			default:
+				archive, _, err := client.Jobs.GetJobArtifacts(pid, jobID)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Errorf("failed to download artifacts archive: %w", err).Error()), nil
+				}
+				data, err := io.ReadAll(archive)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Errorf("failed to read artifacts archive: %w", err).Error()), nil
+				}
+				if result.Files, err = listArchiveFiles(data); err != nil {
+					return mcp.NewToolResultError(fmt.Errorf("failed to read artifacts archive: %w", err).Error()), nil
+				}
+			}
+		}
+
+		jsonData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// escapeArtifactPath escapes each segment of a path inside an artifacts archive for the request path,
+// so names with characters such as ? or # reach GitLab as part of the file name
+func escapeArtifactPath(artifactPath string) string {
+	segments := strings.Split(artifactPath, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// artifactFileResult downloads an artifact file from the given API path and marshals it as the tool response
+func artifactFileResult(client *gitlab.Client, path string, opt interface{}, artifactPath string, maxBytes int) (*mcp.CallToolResult, error) {
+	data, size, err := downloadArtifactFile(client, path, opt, maxBytes)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Errorf("failed to download artifact file: %w", err).Error()), nil
+	}
+
+	jsonData, err := json.Marshal(readArtifactContent(artifactPath, data, size, maxBytes))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// GetJobArtifactFile returns a tool for reading a single file from the artifacts archive of a job
+func GetJobArtifactFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_job_artifact_file",
+		mcp.WithDescription(t("TOOL_GET_JOB_ARTIFACT_FILE_DESCRIPTION", "Read a file from the artifacts archive of a job. Text content is returned up to max_bytes; binary files are only described")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("job_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_JOB_ID_DESCRIPTION", "The ID of the job")),
+		),
+		mcp.WithString("artifact_path",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ARTIFACT_PATH_DESCRIPTION", "The path of the file inside the artifacts archive, e.g. coverage/report.xml")),
+		),
+		withMaxArtifactBytes(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		jobID, err := RequiredInt(r, "job_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		artifactPath, err := requiredParam[string](r, "artifact_path")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		maxBytes, err := optionalMaxArtifactBytes(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		path := fmt.Sprintf("projects/%s/jobs/%d/artifacts/%s", gitlab.PathEscape(fmt.Sprintf("%s/%s", namespace, project)), jobID, escapeArtifactPath(artifactPath))
+		return artifactFileResult(client, path, nil, artifactPath, maxBytes)
+	}
+
+	return tool, handler
+}
+
+// GetLatestJobArtifactFile returns a tool for reading a single artifact file of the latest successful job of a ref
+func GetLatestJobArtifactFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_latest_job_artifact_file",
+		mcp.WithDescription(t("TOOL_GET_LATEST_JOB_ARTIFACT_FILE_DESCRIPTION", "Read a file from the artifacts of a job in the latest successful pipeline of a branch or tag. Text content is returned up to max_bytes; binary files are only described")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("ref",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ARTIFACT_REF_DESCRIPTION", "The branch or tag")),
+		),
+		mcp.WithString("job",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ARTIFACT_JOB_DESCRIPTION", "The name of the job")),
+		),
+		mcp.WithString("artifact_path",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ARTIFACT_PATH_DESCRIPTION", "The path of the file inside the artifacts archive, e.g. coverage/report.xml")),
+		),
+		withMaxArtifactBytes(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref, err := requiredParam[string](r, "ref")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		job, err := requiredParam[string](r, "job")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		artifactPath, err := requiredParam[string](r, "artifact_path")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		maxBytes, err := optionalMaxArtifactBytes(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		path := fmt.Sprintf("projects/%s/jobs/artifacts/%s/raw/%s", gitlab.PathEscape(fmt.Sprintf("%s/%s", namespace, project)), gitlab.PathEscape(ref), escapeArtifactPath(artifactPath))
+		return artifactFileResult(client, path, &gitlab.DownloadArtifactsFileOptions{Job: gitlab.Ptr(job)}, artifactPath, maxBytes)
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,265 @@
+package gitlab
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestReadArtifactContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		size     int
+		maxBytes int
+		expected artifactContent
+	}{
+		{
+			name:     "text file",
+			data:     []byte("<coverage line-rate=\"0.8\"/>"),
+			maxBytes: 100,
+			expected: artifactContent{Path: "coverage.xml", Size: 27, ContentType: "text/plain; charset=utf-8", Content: "<coverage line-rate=\"0.8\"/>"},
+		},
+		{
+			name:     "truncated at a rune boundary",
+			data:     []byte("héllo"),
+			maxBytes: 2,
+			expected: artifactContent{Path: "coverage.xml", Size: 6, ContentType: "text/plain; charset=utf-8", Content: "h", Truncated: true},
+		},
+		{
+			name:     "binary file",
+			data:     []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n', 0, 0},
+			maxBytes: 100,
+			expected: artifactContent{Path: "coverage.xml", Size: 10, ContentType: "image/png", Binary: true},
+		},
+		{
+			name:     "invalid UTF-8",
+			data:     []byte{'a', 0xff, 'b'},
+			maxBytes: 100,
+			expected: artifactContent{Path: "coverage.xml", Size: 3, ContentType: "text/plain; charset=utf-8", Binary: true},
+		},
+		{
+			name:     "invalid UTF-8 after the returned content",
+			data:     []byte{'a', 'b', 'c', 0xff},
+			maxBytes: 3,
+			expected: artifactContent{Path: "coverage.xml", Size: 4, ContentType: "text/plain; charset=utf-8", Content: "abc", Truncated: true},
+		},
+		{
+			name:     "start of a larger file",
+			data:     []byte("abcdef"),
+			size:     1000,
+			maxBytes: 100,
+			expected: artifactContent{Path: "coverage.xml", Size: 1000, ContentType: "text/plain; charset=utf-8", Content: "abcdef", Truncated: true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			size := tc.size
+			if size == 0 {
+				size = len(tc.data)
+			}
+			assert.Equal(t, tc.expected, readArtifactContent("coverage.xml", tc.data, size, tc.maxBytes))
+		})
+	}
+}
+
+func TestListJobArtifacts(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+	_, err := writer.Create("coverage/")
+	require.NoError(t, err)
+	file, err := writer.Create("coverage/cobertura.xml")
+	require.NoError(t, err)
+	_, err = file.Write([]byte("<coverage/>"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	tests := []struct {
+		name        string
+		archiveSize int
+		expected    jobArtifacts
+	}{
+		{
+			name:        "archive files are listed",
+			archiveSize: archive.Len(),
+			expected: jobArtifacts{
+				JobID:     5,
+				Job:       "test",
+				Ref:       "main",
+				Status:    "success",
+				Artifacts: []jobArtifact{{FileType: "archive", Filename: "artifacts.zip", Size: archive.Len(), FileFormat: "zip"}},
+				Files:     []artifactEntry{{Path: "coverage/cobertura.xml", Size: 11}},
+			},
+		},
+		{
+			name:        "large archive is not downloaded",
+			archiveSize: maxArtifactsArchiveBytes + 1,
+			expected: jobArtifacts{
+				JobID:            5,
+				Job:              "test",
+				Ref:              "main",
+				Status:           "success",
+				Artifacts:        []jobArtifact{{FileType: "archive", Filename: "artifacts.zip", Size: maxArtifactsArchiveBytes + 1, FileFormat: "zip"}},
+				FilesUnavailable: "the artifacts archive is 20971521 bytes, over the 20971520 bytes that are listed",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Jobs: &mockJobsService{
+						getFunc: func(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							job := &gitlab.Job{ID: jobID, Name: "test", Ref: "main", Status: "success"}
+							job.Artifacts = append(job.Artifacts, struct {
+								FileType   string `json:"file_type"`
+								Filename   string `json:"filename"`
+								Size       int    `json:"size"`
+								FileFormat string `json:"file_format"`
+							}{FileType: "archive", Filename: "artifacts.zip", Size: tc.archiveSize, FileFormat: "zip"})
+							job.ArtifactsFile.Filename = "artifacts.zip"
+							job.ArtifactsFile.Size = tc.archiveSize
+							return job, ok, nil
+						},
+						getArtifactsFunc: func(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+							assert.LessOrEqual(t, tc.archiveSize, maxArtifactsArchiveBytes)
+							return bytes.NewReader(archive.Bytes()), ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListJobArtifacts(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"namespace":     "group",
+				"project":       "project",
+				"job_id":        float64(5),
+				"include_files": true,
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
+
+			var got jobArtifacts
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestGetLatestJobArtifactFile(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/group/project/jobs/artifacts/main/raw/reports/lint.txt", r.URL.Path)
+		assert.Equal(t, "lint", r.URL.Query().Get("job"))
+		fmt.Fprint(w, strings.Repeat("warning\n", 10))
+	}))
+	defer srv.Close()
+
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := GetLatestJobArtifactFile(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"namespace":     "group",
+		"project":       "project",
+		"ref":           "main",
+		"job":           "lint",
+		"artifact_path": "reports/lint.txt",
+		"max_bytes":     float64(16),
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var got artifactContent
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.Equal(t, artifactContent{Path: "reports/lint.txt", Size: 80, ContentType: "text/plain; charset=utf-8", Content: "warning\nwarning\n", Truncated: true}, got)
+}
+
+func TestGetJobArtifactFile(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the characters of the file name are not taken as a query or fragment
+		assert.Equal(t, "/api/v4/projects/group/project/jobs/7/artifacts/reports/build #2?.txt", r.URL.Path)
+		assert.Empty(t, r.URL.RawQuery)
+		fmt.Fprint(w, "ok\n")
+	}))
+	defer srv.Close()
+
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := GetJobArtifactFile(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"namespace":     "group",
+		"project":       "project",
+		"job_id":        float64(7),
+		"artifact_path": "reports/build #2?.txt",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var got artifactContent
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.Equal(t, "ok\n", got.Content)
+}
+
+func TestDownloadArtifactFile(t *testing.T) {
+	// a 1 MB log with an invalid byte near its end
+	log := append(bytes.Repeat([]byte("ok\n"), 1<<20/3), 0xff)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/group/project/jobs/7/artifacts/job.log", r.URL.Path)
+		_, _ = w.Write(log)
+	}))
+	defer srv.Close()
+
+	client, err := gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+	require.NoError(t, err)
+
+	data, size, err := downloadArtifactFile(client, "projects/group%2Fproject/jobs/7/artifacts/job.log", nil, 100)
+	require.NoError(t, err)
+	assert.Equal(t, len(log), size)
+	// only enough for the binary check is kept
+	assert.Len(t, data, binarySniffBytes+utf8.UTFMax)
+
+	content := readArtifactContent("job.log", data, size, 100)
+	assert.False(t, content.Binary)
+	assert.True(t, content.Truncated)
+	assert.Equal(t, 100, len(content.Content))
+}
@@ -0,0 +1,266 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// awardEmojiTarget identifies the issue, merge request or note a reaction is on
+type awardEmojiTarget struct {
+	projectID    string
+	id           int
+	mergeRequest bool
+	// noteID is 0 for reactions on the issue or merge request itself
+	noteID int
+}
+
+// award is a reaction with the user who gave it
+type award struct {
+	ID        int        `json:"id"`
+	Name      string     `json:"name"`
+	User      string     `json:"user"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// awardEmojiSummary is the response of list_award_emoji
+type awardEmojiSummary struct {
+	// Counts is the number of reactions per emoji, such as thumbsup
+	Counts map[string]int `json:"counts"`
+	Awards []award        `json:"awards"`
+	// Truncated is set when there were more reactions than listAllPages reads, so the counts only cover those read
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// summarizeAwardEmoji counts the reactions per emoji
+func summarizeAwardEmoji(emoji []*gitlab.AwardEmoji) awardEmojiSummary {
+	summary := awardEmojiSummary{Counts: map[string]int{}, Awards: make([]award, 0, len(emoji))}
+	for _, e := range emoji {
+		summary.Counts[e.Name]++
+		summary.Awards = append(summary.Awards, award{ID: e.ID, Name: e.Name, User: e.User.Username, CreatedAt: e.CreatedAt})
+	}
+	return summary
+}
+
+// withAwardEmojiTarget adds the parameters identifying what a reaction is on
+func withAwardEmojiTarget(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		)(tool)
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		)(tool)
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_AWARD_EMOJI_ID_DESCRIPTION", "The ID of the issue or merge request")),
+		)(tool)
+		mcp.WithString("item_type",
+			mcp.Description(t("PARAM_AWARD_EMOJI_ITEM_TYPE_DESCRIPTION", "Whether id is an issue or a merge request. Defaults to issue")),
+			mcp.Enum("issue", "merge_request"),
+		)(tool)
+		mcp.WithNumber("note_id",
+			mcp.Description(t("PARAM_AWARD_EMOJI_NOTE_ID_DESCRIPTION", "The ID of a comment on the issue or merge request, to target the comment instead")),
+		)(tool)
+	}
+}
+
+// readAwardEmojiTarget reads the parameters added by withAwardEmojiTarget
+func readAwardEmojiTarget(r mcp.CallToolRequest) (awardEmojiTarget, error) {
+	var target awardEmojiTarget
+	namespace, err := requiredParam[string](r, "namespace")
+	if err != nil {
+		return target, err
+	}
+	project, err := requiredParam[string](r, "project")
+	if err != nil {
+		return target, err
+	}
+	if target.id, err = RequiredInt(r, "id"); err != nil {
+		return target, err
+	}
+	itemType, err := OptionalParam[string](r, "item_type")
+	if err != nil {
+		return target, err
+	}
+	switch itemType {
+	case "", "issue":
+	case "merge_request":
+		target.mergeRequest = true
+	default:
+		return target, fmt.Errorf("parameter item_type must be issue or merge_request, got %s", itemType)
+	}
+	if target.noteID, err = OptionalInt(r, "note_id"); err != nil {
+		return target, err
+	}
+	target.projectID = fmt.Sprintf("%s/%s", namespace, project)
+	return target, nil
+}
+
+// emojiName normalizes an emoji name such as :thumbsup: to thumbsup
+func emojiName(name string) string {
+	return strings.Trim(strings.TrimSpace(name), ":")
+}
+
+// ListAwardEmoji returns a tool for listing the reactions on an issue, merge request or comment
+func ListAwardEmoji(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_award_emoji",
+		mcp.WithDescription(t("TOOL_LIST_AWARD_EMOJI_DESCRIPTION", "List the emoji reactions on an issue, merge request or comment, with the number of reactions per emoji and who gave them")),
+		withAwardEmojiTarget(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		target, err := readAwardEmojiTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		emoji, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+			listOpts := gitlab.ListAwardEmojiOptions(opts)
+			switch {
+			case target.noteID != 0 && target.mergeRequest:
+				return client.AwardEmoji.ListMergeRequestAwardEmojiOnNote(target.projectID, target.id, target.noteID, &listOpts)
+			case target.noteID != 0:
+				return client.AwardEmoji.ListIssuesAwardEmojiOnNote(target.projectID, target.id, target.noteID, &listOpts)
+			case target.mergeRequest:
+				return client.AwardEmoji.ListMergeRequestAwardEmoji(target.projectID, target.id, &listOpts)
+			default:
+				return client.AwardEmoji.ListIssueAwardEmoji(target.projectID, target.id, &listOpts)
+			}
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list award emoji: %w", err).Error()), nil
+		}
+
+		summary := summarizeAwardEmoji(emoji)
+		summary.Truncated = morePages(resp)
+
+		jsonData, err := json.Marshal(summary)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// AddAwardEmoji returns a tool for reacting to an issue, merge request or comment with an emoji
+func AddAwardEmoji(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"add_award_emoji",
+		mcp.WithDescription(t("TOOL_ADD_AWARD_EMOJI_DESCRIPTION", "React to an issue, merge request or comment with an emoji as the authenticated user")),
+		withAwardEmojiTarget(t),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_AWARD_EMOJI_NAME_DESCRIPTION", "The name of the emoji without colons, such as thumbsup, thumbsdown or white_check_mark")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		target, err := readAwardEmojiTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		name, err := requiredParam[string](r, "name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if name = emojiName(name); name == "" {
+			return mcp.NewToolResultError("parameter name must be an emoji name"), nil
+		}
+
+		opts := &gitlab.CreateAwardEmojiOptions{Name: name}
+		var emoji *gitlab.AwardEmoji
+		switch {
+		case target.noteID != 0 && target.mergeRequest:
+			emoji, _, err = client.AwardEmoji.CreateMergeRequestAwardEmojiOnNote(target.projectID, target.id, target.noteID, opts)
+		case target.noteID != 0:
+			emoji, _, err = client.AwardEmoji.CreateIssuesAwardEmojiOnNote(target.projectID, target.id, target.noteID, opts)
+		case target.mergeRequest:
+			emoji, _, err = client.AwardEmoji.CreateMergeRequestAwardEmoji(target.projectID, target.id, opts)
+		default:
+			emoji, _, err = client.AwardEmoji.CreateIssueAwardEmoji(target.projectID, target.id, opts)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to add award emoji: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(award{ID: emoji.ID, Name: emoji.Name, User: emoji.User.Username, CreatedAt: emoji.CreatedAt})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// RemoveAwardEmoji returns a tool for removing an emoji reaction from an issue, merge request or comment
+func RemoveAwardEmoji(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"remove_award_emoji",
+		mcp.WithDescription(t("TOOL_REMOVE_AWARD_EMOJI_DESCRIPTION", "Remove an emoji reaction from an issue, merge request or comment. Only the user who gave a reaction can remove it")),
+		withAwardEmojiTarget(t),
+		mcp.WithNumber("award_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_AWARD_ID_DESCRIPTION", "The ID of the reaction, as returned by list_award_emoji")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		target, err := readAwardEmojiTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		awardID, err := RequiredInt(r, "award_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		switch {
+		case target.noteID != 0 && target.mergeRequest:
+			_, err = client.AwardEmoji.DeleteMergeRequestAwardEmojiOnNote(target.projectID, target.id, target.noteID, awardID)
+		case target.noteID != 0:
+			_, err = client.AwardEmoji.DeleteIssuesAwardEmojiOnNote(target.projectID, target.id, target.noteID, awardID)
+		case target.mergeRequest:
+			_, err = client.AwardEmoji.DeleteMergeRequestAwardEmoji(target.projectID, target.id, awardID)
+		default:
+			_, err = client.AwardEmoji.DeleteIssueAwardEmoji(target.projectID, target.id, awardID)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to remove award emoji: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Award emoji %d removed", awardID)), nil
+	}
+
+	return tool, handler
+}
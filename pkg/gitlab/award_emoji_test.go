@@ -0,0 +1,294 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockAwardEmojiService is a mock implementation of the GitLab award emoji service
+type mockAwardEmojiService struct {
+	listIssueFunc            func(pid interface{}, issueIID int, opt *gitlab.ListAwardEmojiOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.AwardEmoji, *gitlab.Response, error)
+	listMergeRequestNoteFunc func(pid interface{}, mergeRequestIID, noteID int, opt *gitlab.ListAwardEmojiOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.AwardEmoji, *gitlab.Response, error)
+	createIssueFunc          func(pid interface{}, issueIID int, opt *gitlab.CreateAwardEmojiOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error)
+}
+
+// ensure mockAwardEmojiService implements the gitlab.AwardEmojiServiceInterface
+var _ gitlab.AwardEmojiServiceInterface = &mockAwardEmojiService{}
+
+func (m *mockAwardEmojiService) ListIssueAwardEmoji(pid interface{}, issueIID int, opt *gitlab.ListAwardEmojiOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return m.listIssueFunc(pid, issueIID, opt, options...)
+}
+
+func (m *mockAwardEmojiService) ListMergeRequestAwardEmojiOnNote(pid interface{}, mergeRequestIID, noteID int, opt *gitlab.ListAwardEmojiOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return m.listMergeRequestNoteFunc(pid, mergeRequestIID, noteID, opt, options...)
+}
+
+func (m *mockAwardEmojiService) CreateIssueAwardEmoji(pid interface{}, issueIID int, opt *gitlab.CreateAwardEmojiOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return m.createIssueFunc(pid, issueIID, opt, options...)
+}
+
+func (m *mockAwardEmojiService) CreateIssuesAwardEmojiOnNote(pid interface{}, issueID, noteID int, opt *gitlab.CreateAwardEmojiOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) CreateMergeRequestAwardEmoji(pid interface{}, mergeRequestIID int, opt *gitlab.CreateAwardEmojiOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) CreateMergeRequestAwardEmojiOnNote(pid interface{}, mergeRequestIID, noteID int, opt *gitlab.CreateAwardEmojiOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) CreateSnippetAwardEmoji(pid interface{}, snippetID int, opt *gitlab.CreateAwardEmojiOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) CreateSnippetAwardEmojiOnNote(pid interface{}, snippetIID, noteID int, opt *gitlab.CreateAwardEmojiOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) DeleteIssueAwardEmoji(pid interface{}, issueIID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockAwardEmojiService) DeleteIssuesAwardEmojiOnNote(pid interface{}, issueID, noteID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockAwardEmojiService) DeleteMergeRequestAwardEmoji(pid interface{}, mergeRequestIID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockAwardEmojiService) DeleteMergeRequestAwardEmojiOnNote(pid interface{}, mergeRequestIID, noteID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockAwardEmojiService) DeleteSnippetAwardEmoji(pid interface{}, snippetID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockAwardEmojiService) DeleteSnippetAwardEmojiOnNote(pid interface{}, snippetIID, noteID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockAwardEmojiService) GetIssueAwardEmoji(pid interface{}, issueIID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) GetIssuesAwardEmojiOnNote(pid interface{}, issueID, noteID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) GetMergeRequestAwardEmoji(pid interface{}, mergeRequestIID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) GetMergeRequestAwardEmojiOnNote(pid interface{}, mergeRequestIID, noteID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) GetSnippetAwardEmoji(pid interface{}, snippetID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) GetSnippetAwardEmojiOnNote(pid interface{}, snippetIID, noteID, awardID int, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) ListIssuesAwardEmojiOnNote(pid interface{}, issueID, noteID int, opt *gitlab.ListAwardEmojiOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) ListMergeRequestAwardEmoji(pid interface{}, mergeRequestIID int, opt *gitlab.ListAwardEmojiOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) ListSnippetAwardEmoji(pid interface{}, snippetID int, opt *gitlab.ListAwardEmojiOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockAwardEmojiService) ListSnippetAwardEmojiOnNote(pid interface{}, snippetIID, noteID int, opt *gitlab.ListAwardEmojiOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+// newAwardEmoji builds a reaction, whose user is an anonymous struct in the client library
+func newAwardEmoji(id int, name string, username string) *gitlab.AwardEmoji {
+	emoji := &gitlab.AwardEmoji{ID: id, Name: name}
+	emoji.User.Username = username
+	return emoji
+}
+
+func TestListAwardEmoji(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mockError     error
+		expected      awardEmojiSummary
+		expectedError string
+	}{
+		{
+			name: "reactions on an issue counted",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"id":        float64(7),
+			},
+			expected: awardEmojiSummary{
+				Counts: map[string]int{"thumbsup": 2, "thumbsdown": 1},
+				Awards: []award{
+					{ID: 1, Name: "thumbsup", User: "alice"},
+					{ID: 2, Name: "thumbsup", User: "bob"},
+					{ID: 3, Name: "thumbsdown", User: "carol"},
+				},
+			},
+		},
+		{
+			name: "reactions on a merge request comment",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"id":        float64(5),
+				"item_type": "merge_request",
+				"note_id":   float64(99),
+			},
+			expected: awardEmojiSummary{
+				Counts: map[string]int{"eyes": 1},
+				Awards: []award{{ID: 4, Name: "eyes", User: "dave"}},
+			},
+		},
+		{
+			name: "invalid item type",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"id":        float64(7),
+				"item_type": "epic",
+			},
+			expectedError: "parameter item_type must be issue or merge_request, got epic",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"id":        float64(7),
+			},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to list award emoji: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					AwardEmoji: &mockAwardEmojiService{
+						listIssueFunc: func(pid interface{}, issueIID int, opt *gitlab.ListAwardEmojiOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, 7, issueIID)
+							return []*gitlab.AwardEmoji{
+								newAwardEmoji(1, "thumbsup", "alice"),
+								newAwardEmoji(2, "thumbsup", "bob"),
+								newAwardEmoji(3, "thumbsdown", "carol"),
+							}, ok, tc.mockError
+						},
+						listMergeRequestNoteFunc: func(pid interface{}, mergeRequestIID, noteID int, opt *gitlab.ListAwardEmojiOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+							assert.Equal(t, 5, mergeRequestIID)
+							assert.Equal(t, 99, noteID)
+							return []*gitlab.AwardEmoji{newAwardEmoji(4, "eyes", "dave")}, ok, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListAwardEmoji(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got awardEmojiSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestAddAwardEmoji(t *testing.T) {
+	tests := []struct {
+		name          string
+		emoji         string
+		expectedName  string
+		expectedError string
+	}{
+		{
+			name:         "colons stripped",
+			emoji:        ":thumbsup:",
+			expectedName: "thumbsup",
+		},
+		{
+			name:          "empty name",
+			emoji:         "::",
+			expectedError: "parameter name must be an emoji name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					AwardEmoji: &mockAwardEmojiService{
+						createIssueFunc: func(pid interface{}, issueIID int, opt *gitlab.CreateAwardEmojiOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AwardEmoji, *gitlab.Response, error) {
+							assert.Equal(t, tc.expectedName, opt.Name)
+							return newAwardEmoji(10, opt.Name, "alice"), &gitlab.Response{Response: &http.Response{StatusCode: http.StatusCreated}}, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := AddAwardEmoji(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"id":        float64(7),
+				"name":      tc.emoji,
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got award
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, award{ID: 10, Name: tc.expectedName, User: "alice"}, got)
+		})
+	}
+}
@@ -0,0 +1,481 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+const (
+	// defaultBoardListIssues is how many issues are returned per board list when per_list is not set
+	defaultBoardListIssues = 20
+	// maxBoardListIssues is the largest per_list accepted, matching the GitLab page size limit
+	maxBoardListIssues = 100
+)
+
+// issueBoard is the part of a project or group issue board the board tools need
+type issueBoard struct {
+	ID        int
+	Name      string
+	Milestone *gitlab.Milestone
+	Assignee  string
+	Labels    []string
+	Lists     []*gitlab.BoardList
+}
+
+// boardIssueFilter selects the issues of one board list
+type boardIssueFilter struct {
+	State            string
+	Labels           []string
+	NotLabels        []string
+	Milestone        string
+	AssigneeUsername string
+	IterationID      int
+}
+
+// boardIssue is an issue as shown on a board card
+type boardIssue struct {
+	IID       int      `json:"iid"`
+	ProjectID int      `json:"project_id"`
+	Title     string   `json:"title"`
+	WebURL    string   `json:"web_url"`
+	Assignees []string `json:"assignees"`
+	Labels    []string `json:"labels"`
+	Weight    int      `json:"weight,omitempty"`
+}
+
+// boardListView is a board list with the issues it shows, in board order
+type boardListView struct {
+	ID       int          `json:"id,omitempty"`
+	Kind     string       `json:"kind"`
+	Title    string       `json:"title"`
+	Position int          `json:"position"`
+	Total    int          `json:"total"`
+	Issues   []boardIssue `json:"issues"`
+}
+
+// boardView is the response of the get_board tool
+type boardView struct {
+	ID        int             `json:"id"`
+	Name      string          `json:"name"`
+	Milestone string          `json:"milestone,omitempty"`
+	Assignee  string          `json:"assignee,omitempty"`
+	Labels    []string        `json:"labels,omitempty"`
+	Lists     []boardListView `json:"lists"`
+}
+
+// getIssueBoard gets a board of a project, or of the namespace group when project is empty.
+// When boardID is zero the first board is used, which is the board GitLab opens by default.
+func getIssueBoard(client *gitlab.Client, namespace string, project string, boardID int) (*issueBoard, error) {
+	if project != "" {
+		projectID := fmt.Sprintf("%s/%s", namespace, project)
+		var board *gitlab.IssueBoard
+		if boardID == 0 {
+			boards, _, err := client.Boards.ListIssueBoards(projectID, &gitlab.ListIssueBoardsOptions{PerPage: 1})
+			if err != nil {
+				return nil, err
+			}
+			if len(boards) == 0 {
+				return nil, fmt.Errorf("project %s has no issue boards", projectID)
+			}
+			board = boards[0]
+		} else {
+			var err error
+			if board, _, err = client.Boards.GetIssueBoard(projectID, boardID); err != nil {
+				return nil, err
+			}
+		}
+
+		result := &issueBoard{ID: board.ID, Name: board.Name, Milestone: board.Milestone, Lists: board.Lists}
+		if board.Assignee != nil {
+			result.Assignee = board.Assignee.Username
+		}
+		for _, label := range board.Labels {
+			result.Labels = append(result.Labels, label.Name)
+		}
+		return result, nil
+	}
+
+	var board *gitlab.GroupIssueBoard
+	if boardID == 0 {
+		boards, _, err := client.GroupIssueBoards.ListGroupIssueBoards(namespace, &gitlab.ListGroupIssueBoardsOptions{PerPage: 1})
+		if err != nil {
+			return nil, err
+		}
+		if len(boards) == 0 {
+			return nil, fmt.Errorf("group %s has no issue boards", namespace)
+		}
+		board = boards[0]
+	} else {
+		var err error
+		if board, _, err = client.GroupIssueBoards.GetGroupIssueBoard(namespace, boardID); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &issueBoard{ID: board.ID, Name: board.Name, Milestone: board.Milestone, Lists: board.Lists}
+	for _, label := range board.Labels {
+		result.Labels = append(result.Labels, label.Name)
+	}
+	return result, nil
+}
+
+// describeBoardList names the kind of a board list and the title shown in its header
+func describeBoardList(list *gitlab.BoardList) (kind string, title string) {
+	switch {
+	case list.Label != nil:
+		return "label", list.Label.Name
+	case list.Assignee != nil:
+		return "assignee", list.Assignee.Username
+	case list.Milestone != nil:
+		return "milestone", list.Milestone.Title
+	case list.Iteration != nil:
+		return "iteration", list.Iteration.Title
+	default:
+		return "unknown", ""
+	}
+}
+
+// boardListLabels returns the labels that define the label lists of a board
+func boardListLabels(lists []*gitlab.BoardList) []string {
+	var labels []string
+	for _, list := range lists {
+		if list.Label != nil {
+			labels = append(labels, list.Label.Name)
+		}
+	}
+	return labels
+}
+
+// boardListFilters returns the issue filter of every list of a board, including the Open and Closed lists
+// GitLab shows around the configured lists. The board's own milestone, assignee and label scope applies to all.
+// Issues in the Open list are the open issues not in any label list, as on the board itself.
+func boardListFilters(board *issueBoard) []boardIssueFilter {
+	scope := boardIssueFilter{
+		State:  "opened",
+		Labels: board.Labels,
+	}
+	if board.Milestone != nil {
+		scope.Milestone = board.Milestone.Title
+	}
+	scope.AssigneeUsername = board.Assignee
+
+	filters := make([]boardIssueFilter, 0, len(board.Lists)+2)
+
+	open := scope
+	open.NotLabels = boardListLabels(board.Lists)
+	filters = append(filters, open)
+
+	for _, list := range board.Lists {
+		filter := scope
+		switch {
+		case list.Label != nil:
+			filter.Labels = append(append([]string{}, scope.Labels...), list.Label.Name)
+		case list.Assignee != nil:
+			filter.AssigneeUsername = list.Assignee.Username
+		case list.Milestone != nil:
+			filter.Milestone = list.Milestone.Title
+		case list.Iteration != nil:
+			filter.IterationID = list.Iteration.ID
+		}
+		filters = append(filters, filter)
+	}
+
+	closed := scope
+	closed.State = "closed"
+	filters = append(filters, closed)
+
+	return filters
+}
+
+// listBoardIssues lists the issues matching a board list filter in board order
+func listBoardIssues(client *gitlab.Client, namespace string, project string, filter boardIssueFilter, perList int) ([]*gitlab.Issue, *gitlab.Response, error) {
+	var labels, notLabels *gitlab.LabelOptions
+	if len(filter.Labels) > 0 {
+		labels = (*gitlab.LabelOptions)(&filter.Labels)
+	}
+	if len(filter.NotLabels) > 0 {
+		notLabels = (*gitlab.LabelOptions)(&filter.NotLabels)
+	}
+	var milestone, assignee *string
+	if filter.Milestone != "" {
+		milestone = gitlab.Ptr(filter.Milestone)
+	}
+	if filter.AssigneeUsername != "" {
+		assignee = gitlab.Ptr(filter.AssigneeUsername)
+	}
+	var iteration *int
+	if filter.IterationID != 0 {
+		iteration = gitlab.Ptr(filter.IterationID)
+	}
+
+	if project != "" {
+		return client.Issues.ListProjectIssues(fmt.Sprintf("%s/%s", namespace, project), &gitlab.ListProjectIssuesOptions{
+			ListOptions:      gitlab.ListOptions{PerPage: perList},
+			State:            gitlab.Ptr(filter.State),
+			Labels:           labels,
+			NotLabels:        notLabels,
+			Milestone:        milestone,
+			AssigneeUsername: assignee,
+			IterationID:      iteration,
+			OrderBy:          gitlab.Ptr("relative_position"),
+			Sort:             gitlab.Ptr("asc"),
+		})
+	}
+
+	return client.Issues.ListGroupIssues(namespace, &gitlab.ListGroupIssuesOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: perList},
+		State:            gitlab.Ptr(filter.State),
+		Labels:           labels,
+		NotLabels:        notLabels,
+		Milestone:        milestone,
+		AssigneeUsername: assignee,
+		IterationID:      iteration,
+		OrderBy:          gitlab.Ptr("relative_position"),
+		Sort:             gitlab.Ptr("asc"),
+	})
+}
+
+// toBoardIssue reduces an issue to what a board card shows
+func toBoardIssue(issue *gitlab.Issue) boardIssue {
+	card := boardIssue{
+		IID:       issue.IID,
+		ProjectID: issue.ProjectID,
+		Title:     issue.Title,
+		WebURL:    issue.WebURL,
+		Assignees: []string{},
+		Labels:    issue.Labels,
+		Weight:    issue.Weight,
+	}
+	for _, assignee := range issue.Assignees {
+		card.Assignees = append(card.Assignees, assignee.Username)
+	}
+	return card
+}
+
+// GetBoard returns a tool for getting an issue board with the issues of each of its lists
+func GetBoard(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_board",
+		mcp.WithDescription(t("TOOL_GET_BOARD_DESCRIPTION", "Get an issue board of a project or group with its lists and the issues in each list, in board order")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_BOARD_PROJECT_DESCRIPTION", "The name of the project. Omit to use the boards of the namespace group")),
+		),
+		mcp.WithNumber("board_id",
+			mcp.Description(t("PARAM_BOARD_ID_DESCRIPTION", "The ID of the board. Defaults to the first board")),
+		),
+		mcp.WithNumber("per_list",
+			mcp.Description(t("PARAM_BOARD_PER_LIST_DESCRIPTION", "Maximum number of issues returned per list (default 20, max 100)")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := OptionalParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		boardID, err := OptionalInt(r, "board_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		perList, err := OptionalInt(r, "per_list")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if perList <= 0 {
+			perList = defaultBoardListIssues
+		}
+		if perList > maxBoardListIssues {
+			perList = maxBoardListIssues
+		}
+
+		board, err := getIssueBoard(client, namespace, project, boardID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get board: %w", err).Error()), nil
+		}
+
+		view := boardView{
+			ID:       board.ID,
+			Name:     board.Name,
+			Assignee: board.Assignee,
+			Labels:   board.Labels,
+			Lists:    make([]boardListView, 0, len(board.Lists)+2),
+		}
+		if board.Milestone != nil {
+			view.Milestone = board.Milestone.Title
+		}
+
+		filters := boardListFilters(board)
+		for i, filter := range filters {
+			list := boardListView{Issues: []boardIssue{}}
+			switch i {
+			case 0:
+				list.Kind, list.Title = "open", "Open"
+			case len(filters) - 1:
+				list.Kind, list.Title = "closed", "Closed"
+			default:
+				boardList := board.Lists[i-1]
+				list.ID = boardList.ID
+				list.Position = boardList.Position
+				list.Kind, list.Title = describeBoardList(boardList)
+			}
+
+			issues, resp, err := listBoardIssues(client, namespace, project, filter, perList)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to list issues of board list %s: %w", list.Title, err).Error()), nil
+			}
+			for _, issue := range issues {
+				list.Issues = append(list.Issues, toBoardIssue(issue))
+			}
+			list.Total = len(issues)
+			if resp != nil && resp.TotalItems > list.Total {
+				list.Total = resp.TotalItems
+			}
+
+			view.Lists = append(view.Lists, list)
+		}
+
+		jsonData, err := json.Marshal(view)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// MoveIssueOnBoard returns a tool for moving an issue to another label list of a board
+func MoveIssueOnBoard(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"move_issue_on_board",
+		mcp.WithDescription(t("TOOL_MOVE_ISSUE_ON_BOARD_DESCRIPTION", "Move an issue to a label list of a board by replacing the labels of the board's other label lists with the list's label")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ISSUE_ID_DESCRIPTION", "The ID of the issue")),
+		),
+		mcp.WithNumber("board_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_BOARD_ID_DESCRIPTION", "The ID of the board")),
+		),
+		mcp.WithNumber("to_list_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_BOARD_TO_LIST_ID_DESCRIPTION", "The ID of the label list to move the issue to")),
+		),
+		mcp.WithString("group",
+			mcp.Description(t("PARAM_BOARD_GROUP_DESCRIPTION", "The full path of the group owning the board. Omit when the board belongs to the project")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		issueID, err := RequiredInt(r, "id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		boardID, err := RequiredInt(r, "board_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		toListID, err := RequiredInt(r, "to_list_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		group, err := OptionalParam[string](r, "group")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var board *issueBoard
+		if group != "" {
+			board, err = getIssueBoard(client, group, "", boardID)
+		} else {
+			board, err = getIssueBoard(client, namespace, project, boardID)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get board: %w", err).Error()), nil
+		}
+
+		var target *gitlab.BoardList
+		for _, list := range board.Lists {
+			if list.ID == toListID {
+				target = list
+				break
+			}
+		}
+		if target == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("list %d is not on board %d", toListID, boardID)), nil
+		}
+		if target.Label == nil {
+			kind, _ := describeBoardList(target)
+			return mcp.NewToolResultError(fmt.Sprintf("list %d is not a label list but a %s list; only label lists can be moved to", toListID, kind)), nil
+		}
+
+		var remove []string
+		for _, label := range boardListLabels(board.Lists) {
+			if label != target.Label.Name {
+				remove = append(remove, label)
+			}
+		}
+
+		opts := &gitlab.UpdateIssueOptions{
+			AddLabels: &gitlab.LabelOptions{target.Label.Name},
+		}
+		if len(remove) > 0 {
+			opts.RemoveLabels = (*gitlab.LabelOptions)(&remove)
+		}
+
+		issue, _, err := client.Issues.UpdateIssue(fmt.Sprintf("%s/%s", namespace, project), issueID, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to move issue: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(toBoardIssue(issue))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,185 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockIssueBoardsService is a mock implementation of the GitLab issue boards service
+type mockIssueBoardsService struct {
+	getFunc func(pid interface{}, board int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueBoard, *gitlab.Response, error)
+}
+
+// ensure mockIssueBoardsService implements the gitlab.IssueBoardsServiceInterface
+var _ gitlab.IssueBoardsServiceInterface = &mockIssueBoardsService{}
+
+func (m *mockIssueBoardsService) GetIssueBoard(pid interface{}, board int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueBoard, *gitlab.Response, error) {
+	return m.getFunc(pid, board, options...)
+}
+
+func (m *mockIssueBoardsService) CreateIssueBoard(pid interface{}, opt *gitlab.CreateIssueBoardOptions, options ...gitlab.RequestOptionFunc) (*gitlab.IssueBoard, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockIssueBoardsService) CreateIssueBoardList(pid interface{}, board int, opt *gitlab.CreateIssueBoardListOptions, options ...gitlab.RequestOptionFunc) (*gitlab.BoardList, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockIssueBoardsService) DeleteIssueBoard(pid interface{}, board int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockIssueBoardsService) DeleteIssueBoardList(pid interface{}, board, list int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockIssueBoardsService) GetIssueBoardList(pid interface{}, board, list int, options ...gitlab.RequestOptionFunc) (*gitlab.BoardList, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockIssueBoardsService) GetIssueBoardLists(pid interface{}, board int, opt *gitlab.GetIssueBoardListsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.BoardList, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockIssueBoardsService) ListIssueBoards(pid interface{}, opt *gitlab.ListIssueBoardsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueBoard, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockIssueBoardsService) UpdateIssueBoard(pid interface{}, board int, opt *gitlab.UpdateIssueBoardOptions, options ...gitlab.RequestOptionFunc) (*gitlab.IssueBoard, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockIssueBoardsService) UpdateIssueBoardList(pid interface{}, board, list int, opt *gitlab.UpdateIssueBoardListOptions, options ...gitlab.RequestOptionFunc) (*gitlab.BoardList, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+// testBoard has two label lists, an assignee list and a milestone scope
+func testBoard() *gitlab.IssueBoard {
+	return &gitlab.IssueBoard{
+		ID:        5,
+		Name:      "Development",
+		Milestone: &gitlab.Milestone{Title: "v1.0"},
+		Labels:    []*gitlab.LabelDetails{{Name: "backend"}},
+		Lists: []*gitlab.BoardList{
+			{ID: 11, Label: &gitlab.Label{Name: "workflow::doing"}, Position: 0},
+			{ID: 12, Label: &gitlab.Label{Name: "workflow::review"}, Position: 1},
+			{ID: 13, Assignee: &struct {
+				ID       int    `json:"id"`
+				Name     string `json:"name"`
+				Username string `json:"username"`
+			}{Username: "alice"}, Position: 2},
+		},
+	}
+}
+
+func TestBoardListFilters(t *testing.T) {
+	board := &issueBoard{
+		Milestone: &gitlab.Milestone{Title: "v1.0"},
+		Labels:    []string{"backend"},
+		Lists:     testBoard().Lists,
+	}
+
+	filters := boardListFilters(board)
+	require.Len(t, filters, 5)
+
+	assert.Equal(t, boardIssueFilter{State: "opened", Labels: []string{"backend"}, NotLabels: []string{"workflow::doing", "workflow::review"}, Milestone: "v1.0"}, filters[0])
+	assert.Equal(t, boardIssueFilter{State: "opened", Labels: []string{"backend", "workflow::doing"}, Milestone: "v1.0"}, filters[1])
+	assert.Equal(t, boardIssueFilter{State: "opened", Labels: []string{"backend", "workflow::review"}, Milestone: "v1.0"}, filters[2])
+	assert.Equal(t, boardIssueFilter{State: "opened", Labels: []string{"backend"}, Milestone: "v1.0", AssigneeUsername: "alice"}, filters[3])
+	assert.Equal(t, boardIssueFilter{State: "closed", Labels: []string{"backend"}, Milestone: "v1.0"}, filters[4])
+}
+
+func TestMoveIssueOnBoard(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]interface{}
+		expectedAdd    *gitlab.LabelOptions
+		expectedRemove *gitlab.LabelOptions
+		expectedError  string
+	}{
+		{
+			name: "move to label list",
+			args: map[string]interface{}{
+				"namespace":  "test-namespace",
+				"project":    "test-project",
+				"id":         float64(1),
+				"board_id":   float64(5),
+				"to_list_id": float64(12),
+			},
+			expectedAdd:    &gitlab.LabelOptions{"workflow::review"},
+			expectedRemove: &gitlab.LabelOptions{"workflow::doing"},
+		},
+		{
+			name: "move to assignee list",
+			args: map[string]interface{}{
+				"namespace":  "test-namespace",
+				"project":    "test-project",
+				"id":         float64(1),
+				"board_id":   float64(5),
+				"to_list_id": float64(13),
+			},
+			expectedError: "list 13 is not a label list but a assignee list",
+		},
+		{
+			name: "unknown list",
+			args: map[string]interface{}{
+				"namespace":  "test-namespace",
+				"project":    "test-project",
+				"id":         float64(1),
+				"board_id":   float64(5),
+				"to_list_id": float64(99),
+			},
+			expectedError: "list 99 is not on board 5",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var updated *gitlab.UpdateIssueOptions
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Boards: &mockIssueBoardsService{
+						getFunc: func(pid interface{}, board int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueBoard, *gitlab.Response, error) {
+							assert.Equal(t, "test-namespace/test-project", pid)
+							return testBoard(), &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+					},
+					Issues: &mockIssuesService{
+						updateFunc: func(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+							updated = opt
+							return &gitlab.Issue{IID: issue, Labels: gitlab.Labels{"workflow::review"}}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := MoveIssueOnBoard(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got boardIssue
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			require.NotNil(t, updated)
+			assert.Equal(t, tc.expectedAdd, updated.AddLabels)
+			assert.Equal(t, tc.expectedRemove, updated.RemoveLabels)
+		})
+	}
+}
@@ -0,0 +1,373 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// branchCommitDate returns the date of the last commit on a branch, or the zero time if unknown
+func branchCommitDate(b *gitlab.Branch) time.Time {
+	if b.Commit == nil || b.Commit.CommittedDate == nil {
+		return time.Time{}
+	}
+	return *b.Commit.CommittedDate
+}
+
+// sortBranches sorts branches in place. The GitLab API only returns branches by name,
+// so ordering by last commit has to happen on our side.
+func sortBranches(branches []*gitlab.Branch, order string) error {
+	switch order {
+	case "", "updated_desc":
+		sort.SliceStable(branches, func(i, j int) bool {
+			return branchCommitDate(branches[i]).After(branchCommitDate(branches[j]))
+		})
+	case "updated_asc":
+		sort.SliceStable(branches, func(i, j int) bool {
+			return branchCommitDate(branches[i]).Before(branchCommitDate(branches[j]))
+		})
+	case "name_asc":
+		sort.SliceStable(branches, func(i, j int) bool {
+			return branches[i].Name < branches[j].Name
+		})
+	default:
+		return fmt.Errorf("invalid sort order: %s", order)
+	}
+	return nil
+}
+
+// branchList is the response of list_branches
+type branchList struct {
+	Branches []*gitlab.Branch `json:"branches"`
+	// Total is the number of branches matching the filters, across all pages
+	Total int `json:"total"`
+	// Truncated is set when the project has more branches than listAllPages reads,
+	// so filtering and sorting only covered the first ones by name
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ListBranches returns a tool for listing branches in a project
+func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_branches",
+		mcp.WithDescription(t("TOOL_LIST_BRANCHES_DESCRIPTION", "List branches in a project, most recently committed first. Up to 1000 branches are read, then filtered, sorted and paginated")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("search",
+			mcp.Description(t("PARAM_BRANCH_SEARCH_DESCRIPTION", "Only return branches whose name contains this string")),
+		),
+		mcp.WithBoolean("merged",
+			mcp.Description(t("PARAM_BRANCH_MERGED_DESCRIPTION", "Only return merged (true) or unmerged (false) branches")),
+		),
+		mcp.WithString("sort",
+			mcp.Description(t("PARAM_BRANCH_SORT_DESCRIPTION", "Sort order of the branches")),
+			mcp.Enum("updated_desc", "updated_asc", "name_asc"),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		order, err := OptionalParam[string](r, "sort")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		search, err := OptionalParam[string](r, "search")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		_, filterMerged := r.Params.Arguments["merged"]
+		merged, err := OptionalParam[bool](r, "merged")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// GitLab returns branches by name and cannot filter on merged, so all pages are read
+		// before filtering, sorting and paginating here
+		branches, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.Branch, *gitlab.Response, error) {
+			listOpts := &gitlab.ListBranchesOptions{ListOptions: opts}
+			if search != "" {
+				listOpts.Search = gitlab.Ptr(search)
+			}
+			return client.Branches.ListBranches(fmt.Sprintf("%s/%s", namespace, project), listOpts)
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list branches: %w", err).Error()), nil
+		}
+
+		if filterMerged {
+			filtered := make([]*gitlab.Branch, 0, len(branches))
+			for _, branch := range branches {
+				if branch.Merged == merged {
+					filtered = append(filtered, branch)
+				}
+			}
+			branches = filtered
+		}
+
+		if err := sortBranches(branches, order); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		jsonData, err := json.Marshal(branchList{
+			Branches:  listPage(branches, pagination),
+			Total:     len(branches),
+			Truncated: morePages(resp),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// GetBranch returns a tool for getting a single branch
+func GetBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_branch",
+		mcp.WithDescription(t("TOOL_GET_BRANCH_DESCRIPTION", "Get a specific branch, including its last commit")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("branch",
+			mcp.Required(),
+			mcp.Description(t("PARAM_BRANCH_DESCRIPTION", "The name of the branch")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		branchName, err := requiredParam[string](r, "branch")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		branch, _, err := client.Branches.GetBranch(
+			fmt.Sprintf("%s/%s", namespace, project),
+			branchName,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get branch: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(branch)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// CreateBranch returns a tool for creating a new branch from an existing ref
+func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"create_branch",
+		mcp.WithDescription(t("TOOL_CREATE_BRANCH_DESCRIPTION", "Create a new branch from a branch name, tag or commit SHA")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("branch",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NEW_BRANCH_DESCRIPTION", "The name of the branch to create")),
+		),
+		mcp.WithString("ref",
+			mcp.Required(),
+			mcp.Description(t("PARAM_BRANCH_REF_DESCRIPTION", "The branch name, tag or commit SHA to create the branch from")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		branchName, err := requiredParam[string](r, "branch")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref, err := requiredParam[string](r, "ref")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		branch, _, err := client.Branches.CreateBranch(
+			fmt.Sprintf("%s/%s", namespace, project),
+			&gitlab.CreateBranchOptions{
+				Branch: &branchName,
+				Ref:    &ref,
+			},
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to create branch: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(branch)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// DeleteBranch returns a tool for deleting a branch
+func DeleteBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"delete_branch",
+		mcp.WithDescription(t("TOOL_DELETE_BRANCH_DESCRIPTION", "Delete a branch")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("branch",
+			mcp.Required(),
+			mcp.Description(t("PARAM_BRANCH_DESCRIPTION", "The name of the branch")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		branchName, err := requiredParam[string](r, "branch")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		_, err = client.Branches.DeleteBranch(
+			fmt.Sprintf("%s/%s", namespace, project),
+			branchName,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to delete branch: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Branch %s deleted", branchName)), nil
+	}
+
+	return tool, handler
+}
+
+// DeleteMergedBranches returns a tool for deleting all branches merged into the default branch
+func DeleteMergedBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"delete_merged_branches",
+		mcp.WithDescription(t("TOOL_DELETE_MERGED_BRANCHES_DESCRIPTION", "Delete all branches that are merged into the default branch. Protected branches are kept")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		_, err = client.Branches.DeleteMergedBranches(fmt.Sprintf("%s/%s", namespace, project))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to delete merged branches: %w", err).Error()), nil
+		}
+
+		// GitLab deletes the branches asynchronously
+		return mcp.NewToolResultText("Deletion of merged branches has been scheduled"), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,306 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockBranchesService is a mock implementation of the GitLab branches service
+type mockBranchesService struct {
+	listFunc   func(pid interface{}, opts *gitlab.ListBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Branch, *gitlab.Response, error)
+	getFunc    func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	createFunc func(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+}
+
+// ensure mockBranchesService implements the gitlab.BranchesServiceInterface
+var _ gitlab.BranchesServiceInterface = &mockBranchesService{}
+
+func (m *mockBranchesService) ListBranches(pid interface{}, opts *gitlab.ListBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Branch, *gitlab.Response, error) {
+	return m.listFunc(pid, opts, options...)
+}
+
+func (m *mockBranchesService) GetBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+	return m.getFunc(pid, branch, options...)
+}
+
+func (m *mockBranchesService) CreateBranch(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+	return m.createFunc(pid, opt, options...)
+}
+
+func (m *mockBranchesService) DeleteBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockBranchesService) DeleteMergedBranches(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockBranchesService) ProtectBranch(pid interface{}, branch string, opts *gitlab.ProtectBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockBranchesService) UnprotectBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestListBranches(t *testing.T) {
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	branches := func() []*gitlab.Branch {
+		return []*gitlab.Branch{
+			{Name: "feature-a", Merged: true, Commit: &gitlab.Commit{CommittedDate: &older}},
+			{Name: "feature-b", Merged: false, Commit: &gitlab.Commit{CommittedDate: &newer}},
+			{Name: "main", Default: true},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mockResponse  []*gitlab.Branch
+		mockError     error
+		expectedNames []string
+		expectedTotal int
+		expectedError string
+	}{
+		{
+			name: "sorted by last commit by default",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+			},
+			mockResponse:  branches(),
+			expectedNames: []string{"feature-b", "feature-a", "main"},
+			expectedTotal: 3,
+		},
+		{
+			name: "filter merged branches",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"merged":    true,
+			},
+			mockResponse:  branches(),
+			expectedNames: []string{"feature-a"},
+			expectedTotal: 1,
+		},
+		{
+			name: "filter unmerged branches",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"merged":    false,
+			},
+			mockResponse:  branches(),
+			expectedNames: []string{"feature-b", "main"},
+			expectedTotal: 2,
+		},
+		{
+			name: "paginated after sorting",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"page":      float64(2),
+				"per_page":  float64(1),
+			},
+			mockResponse:  branches(),
+			expectedNames: []string{"feature-a"},
+			expectedTotal: 3,
+		},
+		{
+			name: "sorted by name",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"sort":      "name_asc",
+			},
+			mockResponse:  branches(),
+			expectedNames: []string{"feature-a", "feature-b", "main"},
+			expectedTotal: 3,
+		},
+		{
+			name: "invalid sort order",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"sort":      "random",
+			},
+			mockResponse:  branches(),
+			expectedError: "invalid sort order: random",
+		},
+		{
+			name: "invalid merged type",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"merged":    "true",
+			},
+			expectedError: "parameter merged is not of type bool",
+		},
+		{
+			name: "invalid search type",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"search":    float64(1),
+			},
+			expectedError: "parameter search is not of type string",
+		},
+		{
+			name: "missing required parameter",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+			},
+			expectedError: "missing required parameter: project",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+			},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to list branches: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Branches: &mockBranchesService{
+						listFunc: func(pid interface{}, opts *gitlab.ListBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Branch, *gitlab.Response, error) {
+							assert.Equal(t, "test-namespace/test-project", pid)
+							// serve two branches per page, so every page has to be read before sorting
+							page := opts.Page
+							if page == 0 {
+								page = 1
+							}
+							resp := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+							start, end := (page-1)*2, page*2
+							if end < len(tc.mockResponse) {
+								resp.NextPage = page + 1
+							} else {
+								end = len(tc.mockResponse)
+							}
+							if start > end {
+								start = end
+							}
+							return tc.mockResponse[start:end], resp, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListBranches(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got branchList
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			names := make([]string, 0, len(got.Branches))
+			for _, branch := range got.Branches {
+				names = append(names, branch.Name)
+			}
+			assert.Equal(t, tc.expectedNames, names)
+			assert.Equal(t, tc.expectedTotal, got.Total)
+			assert.False(t, got.Truncated)
+		})
+	}
+}
+
+func TestCreateBranch(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mockError     error
+		expectedError string
+	}{
+		{
+			name: "successful create branch",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"branch":    "feature",
+				"ref":       "main",
+			},
+		},
+		{
+			name: "missing ref",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"branch":    "feature",
+			},
+			expectedError: "missing required parameter: ref",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"branch":    "feature",
+				"ref":       "main",
+			},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to create branch: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Branches: &mockBranchesService{
+						createFunc: func(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+							if tc.mockError != nil {
+								return nil, nil, tc.mockError
+							}
+							return &gitlab.Branch{Name: *opt.Branch}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusCreated}}, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := CreateBranch(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var branch gitlab.Branch
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &branch))
+			assert.Equal(t, "feature", branch.Name)
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+const (
+	// changelogSourceTrailers marks notes generated by GitLab from commit trailers
+	changelogSourceTrailers = "trailers"
+	// changelogSourceMergeRequests marks notes built from the merged merge requests
+	changelogSourceMergeRequests = "merge_requests"
+
+	// changelogOtherGroup is the heading for merge requests without a matching label
+	changelogOtherGroup = "Other changes"
+)
+
+// changelogResult is the response of the generate_changelog tool
+type changelogResult struct {
+	Source string `json:"source"`
+	Notes  string `json:"notes"`
+	// Truncated is set when there were more merged merge requests than listAllPages reads,
+	// so notes built from merge requests may miss entries
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// changelogHasEntries reports whether GitLab found any trailer-based entries.
+// Entries are rendered as Markdown list items; without them GitLab only emits "No changes.".
+func changelogHasEntries(notes string) bool {
+	for _, line := range strings.Split(notes, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "- ") {
+			return true
+		}
+	}
+	return false
+}
+
+// renderMergeRequestChangelog builds Markdown release notes from merge requests grouped by label.
+// When groupLabels is set, a merge request is listed under the first of those labels it carries;
+// otherwise it is listed under its own first label. Unmatched merge requests go to "Other changes".
+func renderMergeRequestChangelog(version string, mrs []*gitlab.BasicMergeRequest, groupLabels []string) string {
+	groups := make(map[string][]*gitlab.BasicMergeRequest)
+	var order []string
+
+	for _, mr := range mrs {
+		group := changelogOtherGroup
+		if len(groupLabels) > 0 {
+			for _, label := range groupLabels {
+				if hasLabel(mr.Labels, label) {
+					group = label
+					break
+				}
+			}
+		} else if len(mr.Labels) > 0 {
+			group = mr.Labels[0]
+		}
+		if _, ok := groups[group]; !ok {
+			order = append(order, group)
+		}
+		groups[group] = append(groups[group], mr)
+	}
+
+	// Keep the caller's label order, otherwise sort alphabetically. "Other changes" always goes last.
+	var headings []string
+	if len(groupLabels) > 0 {
+		headings = append(headings, groupLabels...)
+	} else {
+		for _, group := range order {
+			if group != changelogOtherGroup {
+				headings = append(headings, group)
+			}
+		}
+		sort.Strings(headings)
+	}
+	headings = append(headings, changelogOtherGroup)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n", version)
+	if len(mrs) == 0 {
+		b.WriteString("\nNo changes.\n")
+		return b.String()
+	}
+
+	for _, group := range headings {
+		if len(groups[group]) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n### %s\n\n", group)
+		for _, mr := range groups[group] {
+			fmt.Fprintf(&b, "- %s (!%d)", mr.Title, mr.IID)
+			if mr.Author != nil {
+				fmt.Fprintf(&b, " @%s", mr.Author.Username)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// hasLabel reports whether labels contains label
+func hasLabel(labels gitlab.Labels, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// mergedMergeRequestsBetween returns the merge requests whose merge, squash or head commit is part of
+// the commits between from and to, oldest merge first. It also reports whether there were more merged
+// merge requests than were read.
+func mergedMergeRequestsBetween(client *gitlab.Client, pid string, from string, to string) ([]*gitlab.BasicMergeRequest, bool, error) {
+	compare, _, err := client.Repositories.Compare(pid, &gitlab.CompareOptions{
+		From: &from,
+		To:   &to,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to compare refs: %w", err)
+	}
+
+	shas := make(map[string]bool, len(compare.Commits))
+	for _, commit := range compare.Commits {
+		shas[commit.ID] = true
+	}
+	if len(shas) == 0 {
+		return nil, false, nil
+	}
+
+	fromCommit, _, err := client.Commits.GetCommit(pid, from, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get commit for %s: %w", from, err)
+	}
+
+	merged, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		return client.MergeRequests.ListProjectMergeRequests(pid, &gitlab.ListProjectMergeRequestsOptions{
+			ListOptions:  opts,
+			State:        gitlab.Ptr("merged"),
+			UpdatedAfter: fromCommit.CommittedDate,
+		})
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list merged merge requests: %w", err)
+	}
+
+	var mrs []*gitlab.BasicMergeRequest
+	for _, mr := range merged {
+		if shas[mr.MergeCommitSHA] || shas[mr.SquashCommitSHA] || shas[mr.SHA] {
+			mrs = append(mrs, mr)
+		}
+	}
+
+	sort.SliceStable(mrs, func(i, j int) bool {
+		if mrs[i].MergedAt == nil || mrs[j].MergedAt == nil {
+			return mrs[j].MergedAt == nil && mrs[i].MergedAt != nil
+		}
+		return mrs[i].MergedAt.Before(*mrs[j].MergedAt)
+	})
+
+	return mrs, morePages(resp), nil
+}
+
+// GenerateChangelog returns a tool for generating release notes between two refs
+func GenerateChangelog(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"generate_changelog",
+		mcp.WithDescription(t("TOOL_GENERATE_CHANGELOG_DESCRIPTION", "Generate release notes between two tags from changelog commit trailers, falling back to merged merge requests grouped by label")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("version",
+			mcp.Required(),
+			mcp.Description(t("PARAM_CHANGELOG_VERSION_DESCRIPTION", "The version to generate the changelog for, e.g. 1.2.0")),
+		),
+		mcp.WithString("from",
+			mcp.Required(),
+			mcp.Description(t("PARAM_CHANGELOG_FROM_DESCRIPTION", "The tag or commit SHA of the previous release")),
+		),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description(t("PARAM_CHANGELOG_TO_DESCRIPTION", "The tag, branch or commit SHA of the new release")),
+		),
+		mcp.WithString("trailer",
+			mcp.Description(t("PARAM_CHANGELOG_TRAILER_DESCRIPTION", "The Git trailer used to include commits (default Changelog)")),
+		),
+		mcp.WithArray("group_labels",
+			mcp.Description(t("PARAM_CHANGELOG_GROUP_LABELS_DESCRIPTION", "Labels to group merge requests by, in order, when building notes from merge requests")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		version, err := requiredParam[string](r, "version")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		from, err := requiredParam[string](r, "from")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		to, err := requiredParam[string](r, "to")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		groupLabels, err := OptionalStringArrayParam(r, "group_labels")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		trailer, err := OptionalParam[string](r, "trailer")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		projectID := fmt.Sprintf("%s/%s", namespace, project)
+
+		opts := gitlab.GenerateChangelogDataOptions{
+			Version: &version,
+			From:    &from,
+			To:      &to,
+		}
+		if trailer != "" {
+			opts.Trailer = &trailer
+		}
+
+		result := changelogResult{Source: changelogSourceTrailers}
+
+		data, _, err := client.Repositories.GenerateChangelogData(projectID, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to generate changelog: %w", err).Error()), nil
+		}
+		result.Notes = data.Notes
+
+		if !changelogHasEntries(data.Notes) {
+			mrs, truncated, err := mergedMergeRequestsBetween(client, projectID, from, to)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			result.Source = changelogSourceMergeRequests
+			result.Notes = renderMergeRequestChangelog(version, mrs, groupLabels)
+			result.Truncated = truncated
+		}
+
+		jsonData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,172 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestChangelogHasEntries(t *testing.T) {
+	assert.False(t, changelogHasEntries("## 1.0.0 (2025-01-01)\n\nNo changes.\n"))
+	assert.True(t, changelogHasEntries("## 1.0.0 (2025-01-01)\n\n### added (1 change)\n\n- [Add feature](group/project@abc)\n"))
+}
+
+func TestRenderMergeRequestChangelog(t *testing.T) {
+	mrs := []*gitlab.BasicMergeRequest{
+		{IID: 1, Title: "Fix crash", Labels: gitlab.Labels{"bug", "backend"}, Author: &gitlab.BasicUser{Username: "alice"}},
+		{IID: 2, Title: "Add export", Labels: gitlab.Labels{"feature"}},
+		{IID: 3, Title: "Bump deps"},
+	}
+
+	tests := []struct {
+		name        string
+		mrs         []*gitlab.BasicMergeRequest
+		groupLabels []string
+		expected    string
+	}{
+		{
+			name:        "grouped by given labels in order",
+			mrs:         mrs,
+			groupLabels: []string{"feature", "bug"},
+			expected: "## 1.1.0\n" +
+				"\n### feature\n\n- Add export (!2)\n" +
+				"\n### bug\n\n- Fix crash (!1) @alice\n" +
+				"\n### Other changes\n\n- Bump deps (!3)\n",
+		},
+		{
+			name: "grouped by first label",
+			mrs:  mrs,
+			expected: "## 1.1.0\n" +
+				"\n### bug\n\n- Fix crash (!1) @alice\n" +
+				"\n### feature\n\n- Add export (!2)\n" +
+				"\n### Other changes\n\n- Bump deps (!3)\n",
+		},
+		{
+			name:     "no merge requests",
+			expected: "## 1.1.0\n\nNo changes.\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, renderMergeRequestChangelog("1.1.0", tc.mrs, tc.groupLabels))
+		})
+	}
+}
+
+func TestGenerateChangelog(t *testing.T) {
+	tests := []struct {
+		name              string
+		from              string
+		notes             string
+		moreMergeRequests bool
+		expected          changelogResult
+		expectedError     string
+	}{
+		{
+			name:     "notes from trailers",
+			from:     "v1.0.0",
+			notes:    "## 1.1.0 (2025-02-01)\n\n### added (1 change)\n\n- [Add export](group/project@bbb)\n",
+			expected: changelogResult{Source: changelogSourceTrailers, Notes: "## 1.1.0 (2025-02-01)\n\n### added (1 change)\n\n- [Add export](group/project@bbb)\n"},
+		},
+		{
+			name:     "notes from merged merge requests",
+			from:     "v1.0.0",
+			notes:    "## 1.1.0 (2025-02-01)\n\nNo changes.\n",
+			expected: changelogResult{Source: changelogSourceMergeRequests, Notes: "## 1.1.0\n\n### bug\n\n- Fix crash (!1) @alice\n"},
+		},
+		{
+			name:              "merged merge requests cut off",
+			from:              "v1.0.0",
+			notes:             "## 1.1.0 (2025-02-01)\n\nNo changes.\n",
+			moreMergeRequests: true,
+			expected:          changelogResult{Source: changelogSourceMergeRequests, Notes: "## 1.1.0\n\n### bug\n\n- Fix crash (!1) @alice\n", Truncated: true},
+		},
+		{
+			name:          "unknown from ref",
+			from:          "v0.0.0",
+			expectedError: "failed to generate changelog",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v4/projects/group/project/repository/changelog":
+					assert.Equal(t, "1.1.0", r.URL.Query().Get("version"))
+					assert.Equal(t, "v1.1.0", r.URL.Query().Get("to"))
+					if r.URL.Query().Get("from") != "v1.0.0" {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						fmt.Fprint(w, `{"message":"Failed to find from ref"}`)
+						return
+					}
+					notes, _ := json.Marshal(tc.notes)
+					fmt.Fprintf(w, `{"notes":%s}`, notes)
+				case "/api/v4/projects/group/project/repository/compare":
+					fmt.Fprint(w, `{"commits":[{"id":"aaa"},{"id":"bbb"}]}`)
+				case "/api/v4/projects/group/project/repository/commits/v1.0.0":
+					fmt.Fprint(w, `{"id":"000","committed_date":"2025-01-01T00:00:00Z"}`)
+				case "/api/v4/projects/group/project/merge_requests":
+					assert.Equal(t, "merged", r.URL.Query().Get("state"))
+					page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+					if page == 0 {
+						page = 1
+					}
+					if tc.moreMergeRequests {
+						w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+					}
+					if page > 1 {
+						// merge requests merged into other branches are skipped
+						fmt.Fprintf(w, `[{"id":%d,"iid":%d,"title":"Elsewhere","merge_commit_sha":"zzz"}]`, page+10, page+10)
+						return
+					}
+					fmt.Fprint(w, `[{"id":11,"iid":1,"title":"Fix crash","labels":["bug"],"author":{"username":"alice"},"merge_commit_sha":"aaa","merged_at":"2025-01-10T00:00:00Z"}]`)
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			}))
+			defer srv.Close()
+
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := GenerateChangelog(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"version":   "1.1.0",
+				"from":      tc.from,
+				"to":        "v1.1.0",
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var got changelogResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ciLintJob is a job of a linted configuration
+type ciLintJob struct {
+	Name         string      `json:"name"`
+	Stage        string      `json:"stage"`
+	When         string      `json:"when,omitempty"`
+	AllowFailure bool        `json:"allow_failure"`
+	Environment  string      `json:"environment,omitempty"`
+	TagList      []string    `json:"tag_list,omitempty"`
+	Only         interface{} `json:"only,omitempty"`
+	Except       interface{} `json:"except,omitempty"`
+}
+
+// ciLintResponse is the response of the project CI lint endpoints.
+// gitlab.ProjectLintResult does not decode the jobs, so the endpoints are called directly.
+type ciLintResponse struct {
+	gitlab.ProjectLintResult
+	Jobs []ciLintJob `json:"jobs"`
+}
+
+// ciLintOptions are the parameters of the project CI lint endpoints, for both content and ref validation
+type ciLintOptions struct {
+	Content     *string `url:"content,omitempty" json:"content,omitempty"`
+	ContentRef  *string `url:"content_ref,omitempty" json:"content_ref,omitempty"`
+	DryRun      *bool   `url:"dry_run,omitempty" json:"dry_run,omitempty"`
+	DryRunRef   *string `url:"dry_run_ref,omitempty" json:"dry_run_ref,omitempty"`
+	IncludeJobs *bool   `url:"include_jobs,omitempty" json:"include_jobs,omitempty"`
+	Ref         *string `url:"ref,omitempty" json:"ref,omitempty"`
+}
+
+// lintCIConfig validates the given content in the context of a project, or the project's configuration at ref when content is empty
+func lintCIConfig(client *gitlab.Client, pid string, content string, ref string, dryRun bool, includeJobs bool) (*ciLintResponse, error) {
+	opts := &ciLintOptions{}
+	if dryRun {
+		opts.DryRun = gitlab.Ptr(true)
+	}
+	if includeJobs {
+		opts.IncludeJobs = gitlab.Ptr(true)
+	}
+
+	method := http.MethodGet
+	if content != "" {
+		method = http.MethodPost
+		opts.Content = gitlab.Ptr(content)
+		if ref != "" {
+			opts.Ref = gitlab.Ptr(ref)
+		}
+	} else if ref != "" {
+		opts.ContentRef = gitlab.Ptr(ref)
+		opts.DryRunRef = gitlab.Ptr(ref)
+	}
+
+	req, err := client.NewRequest(method, fmt.Sprintf("projects/%s/ci/lint", gitlab.PathEscape(pid)), opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(ciLintResponse)
+	if _, err := client.Do(req, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// lintMessage is a lint error or warning with the line it points at, when known
+type lintMessage struct {
+	Message string `json:"message"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+}
+
+var (
+	// yamlPositionPattern matches the position in YAML syntax errors, such as "at line 3 column 5"
+	yamlPositionPattern = regexp.MustCompile(`line (\d+) column (\d+)`)
+	// jobKeyPattern matches the job named by configuration errors, such as "jobs:build:script config should be ..."
+	jobKeyPattern = regexp.MustCompile(`^jobs:([^:\s]+)`)
+)
+
+// lintMessages adds line hints to lint messages. YAML syntax errors carry their position,
+// and errors about a job point at the line defining the job when the content is known.
+func lintMessages(messages []string, content string) []lintMessage {
+	lines := strings.Split(content, "\n")
+	result := make([]lintMessage, 0, len(messages))
+	for _, message := range messages {
+		hint := lintMessage{Message: message}
+		if match := yamlPositionPattern.FindStringSubmatch(message); match != nil {
+			hint.Line, _ = strconv.Atoi(match[1])
+			hint.Column, _ = strconv.Atoi(match[2])
+		} else if match := jobKeyPattern.FindStringSubmatch(message); match != nil && content != "" {
+			for i, line := range lines {
+				if strings.HasPrefix(line, match[1]+":") || strings.HasPrefix(line, fmt.Sprintf("%q:", match[1])) {
+					hint.Line = i + 1
+					break
+				}
+			}
+		}
+		result = append(result, hint)
+	}
+	return result
+}
+
+// ciLintReport is the response of lint_ci_config
+type ciLintReport struct {
+	Valid      bool          `json:"valid"`
+	Errors     []lintMessage `json:"errors"`
+	Warnings   []lintMessage `json:"warnings"`
+	Includes   []string      `json:"includes,omitempty"`
+	MergedYAML string        `json:"merged_yaml,omitempty"`
+	Jobs       []ciLintJob   `json:"jobs,omitempty"`
+}
+
+// LintCIConfig returns a tool for validating a CI/CD configuration
+func LintCIConfig(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"lint_ci_config",
+		mcp.WithDescription(t("TOOL_LINT_CI_CONFIG_DESCRIPTION", "Validate the CI/CD configuration of a project at a ref, or the given YAML in the context of the project. Returns errors and warnings with line hints, and optionally the merged YAML with includes expanded and the jobs that would run")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("content",
+			mcp.Description(t("PARAM_CI_LINT_CONTENT_DESCRIPTION", "The YAML to validate. Defaults to the project's configuration at ref")),
+		),
+		mcp.WithString("ref",
+			mcp.Description(t("PARAM_CI_LINT_REF_DESCRIPTION", "The branch or tag whose configuration is validated, or used as context for content. Defaults to the default branch")),
+		),
+		mcp.WithBoolean("dry_run",
+			mcp.Description(t("PARAM_CI_LINT_DRY_RUN_DESCRIPTION", "Simulate creating a pipeline for ref, which evaluates rules and catches more errors")),
+		),
+		mcp.WithBoolean("include_merged_yaml",
+			mcp.Description(t("PARAM_CI_LINT_INCLUDE_MERGED_YAML_DESCRIPTION", "Return the configuration with all includes expanded")),
+		),
+		mcp.WithBoolean("include_jobs",
+			mcp.Description(t("PARAM_CI_LINT_INCLUDE_JOBS_DESCRIPTION", "Return the jobs of the configuration. With dry_run, only the jobs that would run for ref")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		content, err := OptionalParam[string](r, "content")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref, err := OptionalParam[string](r, "ref")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		dryRun, err := OptionalParam[bool](r, "dry_run")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		includeMergedYAML, err := OptionalParam[bool](r, "include_merged_yaml")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		includeJobs, err := OptionalParam[bool](r, "include_jobs")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		result, err := lintCIConfig(client, fmt.Sprintf("%s/%s", namespace, project), content, ref, dryRun, includeJobs)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to lint CI config: %w", err).Error()), nil
+		}
+
+		report := ciLintReport{
+			Valid:    result.Valid,
+			Errors:   lintMessages(result.Errors, content),
+			Warnings: lintMessages(result.Warnings, content),
+		}
+		for _, include := range result.Includes {
+			report.Includes = append(report.Includes, fmt.Sprintf("%s: %s", include.Type, include.Location))
+		}
+		if includeMergedYAML {
+			report.MergedYAML = result.MergedYaml
+		}
+		if includeJobs {
+			report.Jobs = result.Jobs
+		}
+
+		jsonData, err := json.Marshal(report)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,142 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestLintMessages(t *testing.T) {
+	content := "stages:\n  - test\n\nbuild:\n  stage: test\n\"unit tests\":\n  script: make test\n"
+
+	tests := []struct {
+		name     string
+		message  string
+		expected lintMessage
+	}{
+		{
+			name:     "YAML syntax error",
+			message:  "(<unknown>): did not find expected key while parsing a block mapping at line 4 column 1",
+			expected: lintMessage{Message: "(<unknown>): did not find expected key while parsing a block mapping at line 4 column 1", Line: 4, Column: 1},
+		},
+		{
+			name:     "job error",
+			message:  "jobs:build config should implement a script: or a trigger: keyword",
+			expected: lintMessage{Message: "jobs:build config should implement a script: or a trigger: keyword", Line: 4},
+		},
+		{
+			name:     "job with a quoted name",
+			message:  "jobs:unit tests:script config should be a string or a nested array of strings",
+			expected: lintMessage{Message: "jobs:unit tests:script config should be a string or a nested array of strings"},
+		},
+		{
+			name:     "error without position",
+			message:  "Included file `ci/missing.yml` is empty or does not exist!",
+			expected: lintMessage{Message: "Included file `ci/missing.yml` is empty or does not exist!"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, []lintMessage{tc.expected}, lintMessages([]string{tc.message}, content))
+		})
+	}
+}
+
+func TestLintCIConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]interface{}
+		expectedMethod string
+		expectedQuery  map[string]string
+		expectedBody   map[string]interface{}
+		expected       ciLintReport
+	}{
+		{
+			name: "configuration at a ref with merged YAML and jobs",
+			args: map[string]interface{}{
+				"namespace":           "group",
+				"project":             "project",
+				"ref":                 "feature",
+				"dry_run":             true,
+				"include_merged_yaml": true,
+				"include_jobs":        true,
+			},
+			expectedMethod: http.MethodGet,
+			expectedQuery:  map[string]string{"content_ref": "feature", "dry_run_ref": "feature", "dry_run": "true", "include_jobs": "true"},
+			expected: ciLintReport{
+				Valid:      true,
+				Errors:     []lintMessage{},
+				Warnings:   []lintMessage{{Message: "jobs:build may allow multiple pipelines to run for a single action"}},
+				Includes:   []string{"local: ci/build.yml"},
+				MergedYAML: "build:\n  script: make\n",
+				Jobs:       []ciLintJob{{Name: "build", Stage: "test", When: "on_success"}},
+			},
+		},
+		{
+			name: "supplied content",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"content":   "build:\n  script: make\n",
+			},
+			expectedMethod: http.MethodPost,
+			expectedBody:   map[string]interface{}{"content": "build:\n  script: make\n"},
+			expected: ciLintReport{
+				Valid:    true,
+				Errors:   []lintMessage{},
+				Warnings: []lintMessage{{Message: "jobs:build may allow multiple pipelines to run for a single action", Line: 1}},
+				Includes: []string{"local: ci/build.yml"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v4/projects/group/project/ci/lint", r.URL.Path)
+				assert.Equal(t, tc.expectedMethod, r.Method)
+				for key, value := range tc.expectedQuery {
+					assert.Equal(t, value, r.URL.Query().Get(key), key)
+				}
+				if tc.expectedBody != nil {
+					var body map[string]interface{}
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, tc.expectedBody, body)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"valid":true,"errors":[],"warnings":["jobs:build may allow multiple pipelines to run for a single action"],`+
+					`"merged_yaml":"build:\n  script: make\n","includes":[{"type":"local","location":"ci/build.yml"}],`+
+					`"jobs":[{"name":"build","stage":"test","when":"on_success","allow_failure":false}]}`)
+			}))
+			defer srv.Close()
+
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := LintCIConfig(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
+
+			var got ciLintReport
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,422 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// defaultCodeOwnersSection is the name GitLab gives to entries above the first section header
+const defaultCodeOwnersSection = "codeowners"
+
+// codeOwnersLocations are the places GitLab looks for a CODEOWNERS file, in order of precedence
+var codeOwnersLocations = []string{"CODEOWNERS", "docs/CODEOWNERS", ".gitlab/CODEOWNERS"}
+
+// codeOwnersSectionHeader matches "[Section]", "^[Optional section]" and "[Section][2]",
+// optionally followed by whitespace and the section's default owners. Requiring the whitespace
+// keeps patterns starting with a bracket class, such as "[Tt]ests/", from being read as headers.
+var codeOwnersSectionHeader = regexp.MustCompile(`^(\^)?\[([^\]]+)\](?:\[(\d+)\])?(?:\s+(.*))?$`)
+
+// codeOwnersEntry is a single pattern line of a CODEOWNERS file
+type codeOwnersEntry struct {
+	pattern string
+	matcher *regexp.Regexp
+	owners  []string
+}
+
+// codeOwnersSection is a section of a CODEOWNERS file. Entries of a section are matched
+// independently of other sections, and within a section the last matching entry wins.
+type codeOwnersSection struct {
+	name              string
+	optional          bool
+	approvalsRequired int
+	defaultOwners     []string
+	entries           []codeOwnersEntry
+}
+
+// codeOwnerMatch is the owners a section assigns to a path
+type codeOwnerMatch struct {
+	Section           string   `json:"section"`
+	Optional          bool     `json:"optional"`
+	ApprovalsRequired int      `json:"approvals_required"`
+	Pattern           string   `json:"pattern"`
+	Owners            []string `json:"owners"`
+}
+
+// pathCodeOwners is the owners of a single path across all sections
+type pathCodeOwners struct {
+	Path     string           `json:"path"`
+	Sections []codeOwnerMatch `json:"sections"`
+}
+
+// codeOwnersResult is the response of the get_code_owners tool
+type codeOwnersResult struct {
+	File      string           `json:"file"`
+	Ref       string           `json:"ref,omitempty"`
+	Paths     []pathCodeOwners `json:"paths"`
+	AllOwners []string         `json:"all_owners"`
+	Unowned   []string         `json:"unowned,omitempty"`
+	// Truncated is set when the merge request changes more files than listAllPages reads,
+	// so the owners of the remaining files are missing
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// parseCodeOwners parses a CODEOWNERS file using GitLab's syntax. Sections with the same name
+// (case-insensitive) are combined, as GitLab does.
+func parseCodeOwners(content string) ([]*codeOwnersSection, error) {
+	current := &codeOwnersSection{name: defaultCodeOwnersSection, approvalsRequired: 1}
+	sections := []*codeOwnersSection{current}
+	byName := map[string]*codeOwnersSection{defaultCodeOwnersSection: current}
+
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if m := codeOwnersSectionHeader.FindStringSubmatch(line); m != nil {
+			name := strings.TrimSpace(m[2])
+			key := strings.ToLower(name)
+			section, ok := byName[key]
+			if !ok {
+				section = &codeOwnersSection{name: name, approvalsRequired: 1}
+				byName[key] = section
+				sections = append(sections, section)
+			}
+			section.optional = m[1] != ""
+			switch {
+			case section.optional:
+				// optional sections never block a merge request
+				section.approvalsRequired = 0
+			case m[3] != "":
+				approvals, err := strconv.Atoi(m[3])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid approval count %s", i+1, m[3])
+				}
+				section.approvalsRequired = approvals
+			}
+			if owners := strings.Fields(m[4]); len(owners) > 0 {
+				section.defaultOwners = owners
+			}
+			current = section
+			continue
+		}
+
+		pattern, rest := splitCodeOwnersPattern(line)
+		matcher, err := codeOwnersPatternRegexp(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid pattern %s: %w", i+1, pattern, err)
+		}
+		current.entries = append(current.entries, codeOwnersEntry{
+			pattern: pattern,
+			matcher: matcher,
+			owners:  strings.Fields(rest),
+		})
+	}
+
+	return sections, nil
+}
+
+// splitCodeOwnersPattern splits an entry line into its pattern and owners. Spaces and "#"
+// can be part of the pattern when escaped with a backslash.
+func splitCodeOwnersPattern(line string) (pattern string, rest string) {
+	var b strings.Builder
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if c == '\\' && i+1 < len(line) && (line[i+1] == ' ' || line[i+1] == '\t' || line[i+1] == '#') {
+			b.WriteByte(line[i+1])
+			i++
+			continue
+		}
+		if c == ' ' || c == '\t' {
+			return b.String(), line[i+1:]
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), ""
+}
+
+// codeOwnersPatternRegexp translates a CODEOWNERS pattern into a regexp matched against "/"-prefixed
+// paths. It follows GitLab's normalization: "*" owns everything, patterns without a leading slash
+// match at any depth and a trailing slash owns everything below the directory.
+func codeOwnersPatternRegexp(pattern string) (*regexp.Regexp, error) {
+	if pattern == "*" {
+		pattern = "/**/*"
+	}
+	if !strings.HasPrefix(pattern, "/") {
+		pattern = "/**/" + pattern
+	}
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**/*"
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch c {
+		case '*':
+			if strings.HasPrefix(pattern[i:], "**/") {
+				b.WriteString("(?:.*/)?")
+				i += 2
+			} else if strings.HasPrefix(pattern[i:], "**") {
+				b.WriteString(".*")
+				i++
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				b.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end + 1
+		case '{':
+			end := strings.IndexByte(pattern[i+1:], '}')
+			if end < 0 {
+				b.WriteString(`\{`)
+				continue
+			}
+			alternatives := strings.Split(pattern[i+1:i+1+end], ",")
+			for j, alternative := range alternatives {
+				alternatives[j] = regexp.QuoteMeta(alternative)
+			}
+			b.WriteString("(?:" + strings.Join(alternatives, "|") + ")")
+			i += end + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
+
+// resolveCodeOwners returns the owners of each path, section by section
+func resolveCodeOwners(sections []*codeOwnersSection, paths []string) []pathCodeOwners {
+	result := make([]pathCodeOwners, 0, len(paths))
+	for _, path := range paths {
+		owned := pathCodeOwners{Path: path, Sections: []codeOwnerMatch{}}
+		target := "/" + strings.TrimPrefix(path, "/")
+
+		for _, section := range sections {
+			var match *codeOwnersEntry
+			for i := range section.entries {
+				if section.entries[i].matcher.MatchString(target) {
+					match = &section.entries[i]
+				}
+			}
+			if match == nil {
+				continue
+			}
+
+			owners := match.owners
+			if len(owners) == 0 {
+				owners = section.defaultOwners
+			}
+			if len(owners) == 0 {
+				// An entry without owners explicitly leaves the path unowned in this section
+				continue
+			}
+
+			owned.Sections = append(owned.Sections, codeOwnerMatch{
+				Section:           section.name,
+				Optional:          section.optional,
+				ApprovalsRequired: section.approvalsRequired,
+				Pattern:           match.pattern,
+				Owners:            owners,
+			})
+		}
+
+		result = append(result, owned)
+	}
+	return result
+}
+
+// findCodeOwnersFile returns the path and content of the CODEOWNERS file at ref, or an empty path if there is none
+func findCodeOwnersFile(client *gitlab.Client, pid string, ref string) (string, string, error) {
+	opts := &gitlab.GetRawFileOptions{}
+	if ref != "" {
+		opts.Ref = &ref
+	}
+
+	for _, location := range codeOwnersLocations {
+		content, resp, err := client.RepositoryFiles.GetRawFile(pid, location, opts)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return "", "", fmt.Errorf("failed to get %s: %w", location, err)
+		}
+		return location, string(content), nil
+	}
+
+	return "", "", nil
+}
+
+// mergeRequestChangedPaths returns the paths changed by a merge request, including the old path of renamed files.
+// It also reports whether the merge request had more diffs than were read.
+func mergeRequestChangedPaths(client *gitlab.Client, pid string, iid int) ([]string, bool, error) {
+	diffs, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.MergeRequestDiff, *gitlab.Response, error) {
+		return client.MergeRequests.ListMergeRequestDiffs(pid, iid, &gitlab.ListMergeRequestDiffsOptions{ListOptions: opts})
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list merge request diffs: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var paths []string
+	add := func(path string) {
+		if path != "" && !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+	for _, diff := range diffs {
+		add(diff.NewPath)
+		if diff.RenamedFile {
+			add(diff.OldPath)
+		}
+	}
+
+	return paths, morePages(resp), nil
+}
+
+// GetCodeOwners returns a tool for resolving the code owners of paths or of a merge request
+func GetCodeOwners(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_code_owners",
+		mcp.WithDescription(t("TOOL_GET_CODE_OWNERS_DESCRIPTION", "Get the code owners of a list of paths, or of all files changed in a merge request, from the project's CODEOWNERS file")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithArray("paths",
+			mcp.Description(t("PARAM_CODE_OWNERS_PATHS_DESCRIPTION", "File paths to resolve owners for")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		),
+		mcp.WithNumber("merge_request_id",
+			mcp.Description(t("PARAM_CODE_OWNERS_MERGE_REQUEST_ID_DESCRIPTION", "The ID of a merge request whose changed files should be resolved")),
+		),
+		mcp.WithString("ref",
+			mcp.Description(t("PARAM_CODE_OWNERS_REF_DESCRIPTION", "The ref to read CODEOWNERS from (defaults to the merge request's target branch, or the default branch)")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		paths, err := OptionalStringArrayParam(r, "paths")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		mrID, err := OptionalInt(r, "merge_request_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref, err := OptionalParam[string](r, "ref")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if len(paths) == 0 && mrID == 0 {
+			return mcp.NewToolResultError("either paths or merge_request_id is required"), nil
+		}
+
+		projectID := fmt.Sprintf("%s/%s", namespace, project)
+
+		var truncated bool
+		if mrID != 0 {
+			if ref == "" {
+				mr, _, err := client.MergeRequests.GetMergeRequest(projectID, mrID, nil)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Errorf("failed to get merge request: %w", err).Error()), nil
+				}
+				ref = mr.TargetBranch
+			}
+			changed, more, err := mergeRequestChangedPaths(client, projectID, mrID)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			paths = append(paths, changed...)
+			truncated = more
+		}
+
+		file, content, err := findCodeOwnersFile(client, projectID, ref)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if file == "" {
+			return mcp.NewToolResultText(fmt.Sprintf("No CODEOWNERS file found in %s", strings.Join(codeOwnersLocations, ", "))), nil
+		}
+
+		sections, err := parseCodeOwners(content)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to parse %s: %w", file, err).Error()), nil
+		}
+
+		result := codeOwnersResult{
+			File:      file,
+			Ref:       ref,
+			Paths:     resolveCodeOwners(sections, paths),
+			AllOwners: []string{},
+			Truncated: truncated,
+		}
+		seen := make(map[string]bool)
+		for _, path := range result.Paths {
+			if len(path.Sections) == 0 {
+				result.Unowned = append(result.Unowned, path.Path)
+			}
+			for _, match := range path.Sections {
+				for _, owner := range match.Owners {
+					if !seen[owner] {
+						seen[owner] = true
+						result.AllOwners = append(result.AllOwners, owner)
+					}
+				}
+			}
+		}
+		sort.Strings(result.AllOwners)
+
+		jsonData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
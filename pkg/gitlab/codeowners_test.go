@@ -0,0 +1,306 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockRepositoryFilesService is a mock implementation of the GitLab repository files service
+type mockRepositoryFilesService struct {
+	getRawFunc func(pid interface{}, fileName string, opt *gitlab.GetRawFileOptions, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error)
+}
+
+// ensure mockRepositoryFilesService implements the gitlab.RepositoryFilesServiceInterface
+var _ gitlab.RepositoryFilesServiceInterface = &mockRepositoryFilesService{}
+
+func (m *mockRepositoryFilesService) GetRawFile(pid interface{}, fileName string, opt *gitlab.GetRawFileOptions, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+	return m.getRawFunc(pid, fileName, opt, options...)
+}
+
+func (m *mockRepositoryFilesService) CreateFile(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoryFilesService) DeleteFile(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockRepositoryFilesService) GetFile(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoryFilesService) GetFileBlame(pid interface{}, file string, opt *gitlab.GetFileBlameOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.FileBlameRange, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoryFilesService) GetFileMetaData(pid interface{}, fileName string, opt *gitlab.GetFileMetaDataOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoryFilesService) GetRawFileMetaData(pid interface{}, fileName string, opt *gitlab.GetRawFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoryFilesService) UpdateFile(pid interface{}, fileName string, opt *gitlab.UpdateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+const testCodeOwners = `# Default owners
+* @platform
+*.go @go-reviewers
+/docs/ @tech-writers
+README.md
+
+[Frontend][2] @frontend-team
+app/assets/
+*.vue @vue-experts
+
+^[Security]
+/config/secrets\ dir/ @security
+`
+
+func TestCodeOwnersPatternRegexp(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{"*", "/any/file.txt", true},
+		{"*.go", "/main.go", true},
+		{"*.go", "/pkg/gitlab/server.go", true},
+		{"*.go", "/pkg/gitlab/server.gox", false},
+		{"/docs/", "/docs/api.md", true},
+		{"/docs/", "/docs/nested/page.md", true},
+		{"/docs/", "/pkg/docs/api.md", false},
+		{"docs/", "/pkg/docs/api.md", true},
+		{"README.md", "/pkg/README.md", true},
+		{"/README.md", "/pkg/README.md", false},
+		{"/lib/*.rb", "/lib/a.rb", true},
+		{"/lib/*.rb", "/lib/nested/a.rb", false},
+		{"/lib/**/*.rb", "/lib/nested/a.rb", true},
+		{"*.{js,ts}", "/web/app.ts", true},
+		{"file[0-9].txt", "/file1.txt", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.pattern+" "+tc.path, func(t *testing.T) {
+			re, err := codeOwnersPatternRegexp(tc.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tc.match, re.MatchString(tc.path))
+		})
+	}
+}
+
+func TestParseCodeOwnersSectionHeaders(t *testing.T) {
+	sections, err := parseCodeOwners(`[Tt]ests/ @qa
+[Docs] @writers
+*.md
+^[Optional][2]
+*.txt @reviewers
+`)
+	require.NoError(t, err)
+	require.Len(t, sections, 3)
+
+	assert.Equal(t, defaultCodeOwnersSection, sections[0].name)
+	require.Len(t, sections[0].entries, 1, "a pattern starting with a bracket class is not a section header")
+	assert.Equal(t, "[Tt]ests/", sections[0].entries[0].pattern)
+	assert.Equal(t, []string{"@qa"}, sections[0].entries[0].owners)
+
+	assert.Equal(t, "Docs", sections[1].name)
+	assert.Equal(t, []string{"@writers"}, sections[1].defaultOwners)
+	assert.Equal(t, 1, sections[1].approvalsRequired)
+
+	assert.Equal(t, "Optional", sections[2].name)
+	assert.True(t, sections[2].optional)
+	assert.Equal(t, 0, sections[2].approvalsRequired, "optional sections require no approvals")
+}
+
+func TestResolveCodeOwners(t *testing.T) {
+	sections, err := parseCodeOwners(testCodeOwners)
+	require.NoError(t, err)
+	require.Len(t, sections, 3)
+	assert.Equal(t, 2, sections[1].approvalsRequired)
+	assert.True(t, sections[2].optional)
+
+	owners := resolveCodeOwners(sections, []string{
+		"pkg/server.go",
+		"docs/api.md",
+		"README.md",
+		"app/assets/main.vue",
+		"config/secrets dir/key.pem",
+	})
+
+	assert.Equal(t, []codeOwnerMatch{
+		{Section: "codeowners", ApprovalsRequired: 1, Pattern: "*.go", Owners: []string{"@go-reviewers"}},
+	}, owners[0].Sections, "last matching entry wins")
+	assert.Equal(t, "@tech-writers", owners[1].Sections[0].Owners[0])
+	assert.Empty(t, owners[2].Sections, "an entry without owners leaves the path unowned")
+	assert.Equal(t, []codeOwnerMatch{
+		{Section: "codeowners", ApprovalsRequired: 1, Pattern: "*", Owners: []string{"@platform"}},
+		{Section: "Frontend", ApprovalsRequired: 2, Pattern: "*.vue", Owners: []string{"@vue-experts"}},
+	}, owners[3].Sections)
+	assert.Equal(t, []codeOwnerMatch{
+		{Section: "codeowners", ApprovalsRequired: 1, Pattern: "*", Owners: []string{"@platform"}},
+		{Section: "Security", Optional: true, ApprovalsRequired: 0, Pattern: "/config/secrets dir/", Owners: []string{"@security"}},
+	}, owners[4].Sections)
+}
+
+func TestGetCodeOwners(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]interface{}
+		files          map[string]string
+		expectedFile   string
+		expectedOwners []string
+		expectedText   string
+		expectedError  string
+	}{
+		{
+			name: "CODEOWNERS in .gitlab directory",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"paths":     []interface{}{"main.go", "web/app.vue"},
+			},
+			files:          map[string]string{".gitlab/CODEOWNERS": testCodeOwners},
+			expectedFile:   ".gitlab/CODEOWNERS",
+			expectedOwners: []string{"@go-reviewers", "@platform", "@vue-experts"},
+		},
+		{
+			name: "root CODEOWNERS takes precedence",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"paths":     []interface{}{"main.go"},
+			},
+			files: map[string]string{
+				"CODEOWNERS":         "* @root-owner",
+				".gitlab/CODEOWNERS": testCodeOwners,
+			},
+			expectedFile:   "CODEOWNERS",
+			expectedOwners: []string{"@root-owner"},
+		},
+		{
+			name: "no CODEOWNERS file",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"paths":     []interface{}{"main.go"},
+			},
+			files:        map[string]string{},
+			expectedText: "No CODEOWNERS file found in CODEOWNERS, docs/CODEOWNERS, .gitlab/CODEOWNERS",
+		},
+		{
+			name: "neither paths nor merge request",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+			},
+			expectedError: "either paths or merge_request_id is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					RepositoryFiles: &mockRepositoryFilesService{
+						getRawFunc: func(pid interface{}, fileName string, opt *gitlab.GetRawFileOptions, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+							assert.Equal(t, "test-namespace/test-project", pid)
+							content, ok := tc.files[fileName]
+							if !ok {
+								return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, fmt.Errorf("404 File Not Found")
+							}
+							return []byte(content), &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := GetCodeOwners(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			var got codeOwnersResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expectedFile, got.File)
+			assert.Equal(t, tc.expectedOwners, got.AllOwners)
+		})
+	}
+}
+
+func TestGetCodeOwnersMergeRequestTruncated(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v4/projects/test-namespace/test-project/merge_requests/5":
+			fmt.Fprint(w, `{"id":50,"iid":5,"target_branch":"main"}`)
+		case "/api/v4/projects/test-namespace/test-project/merge_requests/5/diffs":
+			// every page points to a next one, so only maxListPages pages are read
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			if page == 0 {
+				page = 1
+			}
+			w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+			fmt.Fprintf(w, `[{"new_path":"pkg/file%d.go"}]`, page)
+		case "/api/v4/projects/test-namespace/test-project/repository/files/CODEOWNERS/raw":
+			assert.Equal(t, "main", r.URL.Query().Get("ref"))
+			fmt.Fprint(w, "*.go @go-reviewers\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"404 Not Found"}`)
+		}
+	}))
+	defer srv.Close()
+
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := GetCodeOwners(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"namespace":        "test-namespace",
+		"project":          "test-project",
+		"merge_request_id": float64(5),
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var got codeOwnersResult
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.True(t, got.Truncated)
+	assert.Len(t, got.Paths, maxListPages)
+	assert.Equal(t, []string{"@go-reviewers"}, got.AllOwners)
+}
@@ -0,0 +1,308 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListCommits returns a tool for listing the commit history of a project
+func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_commits",
+		mcp.WithDescription(t("TOOL_LIST_COMMITS_DESCRIPTION", "List commits in a project, newest first")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("ref",
+			mcp.Description(t("PARAM_COMMIT_REF_DESCRIPTION", "Branch, tag or commit SHA to list commits from (defaults to the default branch)")),
+		),
+		mcp.WithString("path",
+			mcp.Description(t("PARAM_COMMIT_PATH_DESCRIPTION", "Only return commits touching this file path")),
+		),
+		mcp.WithString("since",
+			mcp.Description(t("PARAM_SINCE_DESCRIPTION", "Only return results after this date (YYYY-MM-DD or RFC 3339)")),
+		),
+		mcp.WithString("until",
+			mcp.Description(t("PARAM_UNTIL_DESCRIPTION", "Only return results before this date (YYYY-MM-DD or RFC 3339)")),
+		),
+		mcp.WithString("author",
+			mcp.Description(t("PARAM_COMMIT_AUTHOR_DESCRIPTION", "Only return commits by this author name or email")),
+		),
+		mcp.WithBoolean("with_stats",
+			mcp.Description(t("PARAM_WITH_STATS_DESCRIPTION", "Include the number of added and deleted lines for each commit")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.ListCommitsOptions{ListOptions: pagination}
+
+		if opts.Since, err = OptionalTime(r, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.Until, err = OptionalTime(r, "until"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref, err := OptionalParam[string](r, "ref")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		path, err := OptionalParam[string](r, "path")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		author, err := OptionalParam[string](r, "author")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		withStats, err := OptionalParam[bool](r, "with_stats")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if ref != "" {
+			opts.RefName = &ref
+		}
+		if path != "" {
+			opts.Path = &path
+		}
+		if author != "" {
+			opts.Author = &author
+		}
+		if withStats {
+			opts.WithStats = &withStats
+		}
+
+		commits, _, err := client.Commits.ListCommits(
+			fmt.Sprintf("%s/%s", namespace, project),
+			opts,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list commits: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(commits)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// commitDetails is the response of the get_commit tool
+type commitDetails struct {
+	Commit *gitlab.Commit      `json:"commit"`
+	Refs   []*gitlab.CommitRef `json:"refs"`
+	budgetedDiffs
+}
+
+// GetCommit returns a tool for getting a single commit with its stats, diff and containing refs
+func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_commit",
+		mcp.WithDescription(t("TOOL_GET_COMMIT_DESCRIPTION", "Get a specific commit with its stats, diff, and the branches and tags containing it")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("sha",
+			mcp.Required(),
+			mcp.Description(t("PARAM_COMMIT_SHA_DESCRIPTION", "The commit SHA, or a branch or tag name")),
+		),
+		withMaxDiffBytes(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		sha, err := requiredParam[string](r, "sha")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		maxDiffBytes, err := optionalMaxDiffBytes(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		projectID := fmt.Sprintf("%s/%s", namespace, project)
+
+		commit, _, err := client.Commits.GetCommit(projectID, sha, &gitlab.GetCommitOptions{
+			Stats: gitlab.Ptr(true),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get commit: %w", err).Error()), nil
+		}
+
+		diffs, diffResp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.Diff, *gitlab.Response, error) {
+			return client.Commits.GetCommitDiff(projectID, commit.ID, &gitlab.GetCommitDiffOptions{ListOptions: opts})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get commit diff: %w", err).Error()), nil
+		}
+
+		refs, _, err := client.Commits.GetCommitRefs(projectID, commit.ID, &gitlab.GetCommitRefsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 100},
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get commit refs: %w", err).Error()), nil
+		}
+
+		details := commitDetails{
+			Commit:        commit,
+			Refs:          refs,
+			budgetedDiffs: budgetDiffs(diffs, maxDiffBytes),
+		}
+		details.FilesTruncated = morePages(diffResp)
+
+		jsonData, err := json.Marshal(details)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// compareResult is the response of the compare_refs tool
+type compareResult struct {
+	Commits        []*gitlab.Commit `json:"commits"`
+	CompareTimeout bool             `json:"compare_timeout"`
+	CompareSameRef bool             `json:"compare_same_ref"`
+	WebURL         string           `json:"web_url"`
+	budgetedDiffs
+}
+
+// CompareRefs returns a tool for comparing two branches, tags or commits
+func CompareRefs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"compare_refs",
+		mcp.WithDescription(t("TOOL_COMPARE_REFS_DESCRIPTION", "Compare two branches, tags or commits, returning the commits and diffs between them")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("from",
+			mcp.Required(),
+			mcp.Description(t("PARAM_COMPARE_FROM_DESCRIPTION", "The base branch, tag or commit SHA")),
+		),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description(t("PARAM_COMPARE_TO_DESCRIPTION", "The head branch, tag or commit SHA")),
+		),
+		mcp.WithBoolean("straight",
+			mcp.Description(t("PARAM_COMPARE_STRAIGHT_DESCRIPTION", "Compare from and to directly instead of from their merge base")),
+		),
+		withMaxDiffBytes(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		from, err := requiredParam[string](r, "from")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		to, err := requiredParam[string](r, "to")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		straight, err := OptionalParam[bool](r, "straight")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		maxDiffBytes, err := optionalMaxDiffBytes(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		compare, _, err := client.Repositories.Compare(
+			fmt.Sprintf("%s/%s", namespace, project),
+			&gitlab.CompareOptions{
+				From:     &from,
+				To:       &to,
+				Straight: &straight,
+			},
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to compare refs: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(compareResult{
+			Commits:        compare.Commits,
+			CompareTimeout: compare.CompareTimeout,
+			CompareSameRef: compare.CompareSameRef,
+			WebURL:         compare.WebURL,
+			budgetedDiffs:  budgetDiffs(compare.Diffs, maxDiffBytes),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,220 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockCommitsService is a mock implementation of the GitLab commits service
+type mockCommitsService struct {
+	listFunc    func(pid interface{}, opt *gitlab.ListCommitsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error)
+	getFunc     func(pid interface{}, sha string, opt *gitlab.GetCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error)
+	getDiffFunc func(pid interface{}, sha string, opt *gitlab.GetCommitDiffOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Diff, *gitlab.Response, error)
+	getRefsFunc func(pid interface{}, sha string, opt *gitlab.GetCommitRefsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.CommitRef, *gitlab.Response, error)
+}
+
+// ensure mockCommitsService implements the gitlab.CommitsServiceInterface
+var _ gitlab.CommitsServiceInterface = &mockCommitsService{}
+
+func (m *mockCommitsService) ListCommits(pid interface{}, opt *gitlab.ListCommitsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error) {
+	return m.listFunc(pid, opt, options...)
+}
+
+func (m *mockCommitsService) GetCommit(pid interface{}, sha string, opt *gitlab.GetCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+	return m.getFunc(pid, sha, opt, options...)
+}
+
+func (m *mockCommitsService) GetCommitDiff(pid interface{}, sha string, opt *gitlab.GetCommitDiffOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Diff, *gitlab.Response, error) {
+	return m.getDiffFunc(pid, sha, opt, options...)
+}
+
+func (m *mockCommitsService) GetCommitRefs(pid interface{}, sha string, opt *gitlab.GetCommitRefsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.CommitRef, *gitlab.Response, error) {
+	return m.getRefsFunc(pid, sha, opt, options...)
+}
+
+func (m *mockCommitsService) CherryPickCommit(pid interface{}, sha string, opt *gitlab.CherryPickCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockCommitsService) CreateCommit(pid interface{}, opt *gitlab.CreateCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockCommitsService) GetCommitComments(pid interface{}, sha string, opt *gitlab.GetCommitCommentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.CommitComment, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockCommitsService) GetCommitStatuses(pid interface{}, sha string, opt *gitlab.GetCommitStatusesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.CommitStatus, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockCommitsService) GetGPGSignature(pid interface{}, sha string, options ...gitlab.RequestOptionFunc) (*gitlab.GPGSignature, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockCommitsService) ListMergeRequestsByCommit(pid interface{}, sha string, options ...gitlab.RequestOptionFunc) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockCommitsService) PostCommitComment(pid interface{}, sha string, opt *gitlab.PostCommitCommentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.CommitComment, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockCommitsService) RevertCommit(pid interface{}, sha string, opt *gitlab.RevertCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockCommitsService) SetCommitStatus(pid interface{}, sha string, opt *gitlab.SetCommitStatusOptions, options ...gitlab.RequestOptionFunc) (*gitlab.CommitStatus, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestListCommits(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mockResponse  []*gitlab.Commit
+		mockError     error
+		expectedError string
+	}{
+		{
+			name: "successful list commits with filters",
+			args: map[string]interface{}{
+				"namespace":  "test-namespace",
+				"project":    "test-project",
+				"ref":        "main",
+				"path":       "README.md",
+				"since":      "2025-01-01",
+				"until":      "2025-02-01T00:00:00Z",
+				"with_stats": true,
+			},
+			mockResponse: []*gitlab.Commit{
+				{ID: "abc123", Title: "Update README"},
+			},
+		},
+		{
+			name: "invalid since date",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"since":     "yesterday",
+			},
+			expectedError: "parameter since is not a valid date",
+		},
+		{
+			name: "with_stats of the wrong type",
+			args: map[string]interface{}{
+				"namespace":  "test-namespace",
+				"project":    "test-project",
+				"with_stats": "yes",
+			},
+			expectedError: "parameter with_stats is not of type bool",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+			},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to list commits: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Commits: &mockCommitsService{
+						listFunc: func(pid interface{}, opt *gitlab.ListCommitsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error) {
+							if ref, ok := tc.args["ref"]; ok {
+								assert.Equal(t, ref, *opt.RefName)
+								assert.Equal(t, "README.md", *opt.Path)
+								assert.Equal(t, 2025, opt.Since.Year())
+								assert.Equal(t, 2, int(opt.Until.Month()))
+								assert.True(t, *opt.WithStats)
+							}
+							return tc.mockResponse, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListCommits(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var commits []*gitlab.Commit
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &commits))
+			require.Len(t, commits, len(tc.mockResponse))
+			assert.Equal(t, tc.mockResponse[0].ID, commits[0].ID)
+		})
+	}
+}
+
+func TestGetCommit(t *testing.T) {
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return &gitlab.Client{
+			Commits: &mockCommitsService{
+				getFunc: func(pid interface{}, sha string, opt *gitlab.GetCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+					assert.True(t, *opt.Stats)
+					return &gitlab.Commit{ID: "abc123", Stats: &gitlab.CommitStats{Additions: 3}}, nil, nil
+				},
+				getDiffFunc: func(pid interface{}, sha string, opt *gitlab.GetCommitDiffOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Diff, *gitlab.Response, error) {
+					// one file per page, so both pages must be read
+					if opt.Page == 0 {
+						return []*gitlab.Diff{{NewPath: "a.go", Diff: "0123456789"}}, &gitlab.Response{NextPage: 2}, nil
+					}
+					assert.Equal(t, 2, opt.Page)
+					return []*gitlab.Diff{{NewPath: "b.go", Diff: "0123456789"}}, &gitlab.Response{}, nil
+				},
+				getRefsFunc: func(pid interface{}, sha string, opt *gitlab.GetCommitRefsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.CommitRef, *gitlab.Response, error) {
+					return []*gitlab.CommitRef{{Type: "branch", Name: "main"}, {Type: "tag", Name: "v1.0.0"}}, nil, nil
+				},
+			},
+		}, nil
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := GetCommit(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"namespace":      "test-namespace",
+		"project":        "test-project",
+		"sha":            "abc123",
+		"max_diff_bytes": float64(15),
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var details commitDetails
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &details))
+	assert.Equal(t, "abc123", details.Commit.ID)
+	assert.Equal(t, 3, details.Commit.Stats.Additions)
+	assert.Len(t, details.Refs, 2)
+	require.Len(t, details.Diffs, 2)
+	assert.Equal(t, []string{"b.go"}, details.TruncatedFiles)
+	assert.False(t, details.FilesTruncated)
+}
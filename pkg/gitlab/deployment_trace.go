@@ -0,0 +1,287 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// environmentTrace tells whether an environment contains a change
+type environmentTrace struct {
+	Environment string `json:"environment"`
+	// Contains is true when the last successful deployment of the environment includes the change
+	Contains         bool       `json:"contains"`
+	LastDeploymentID int        `json:"last_deployment_id,omitempty"`
+	LastDeployedSHA  string     `json:"last_deployed_sha,omitempty"`
+	LastDeployedAt   *time.Time `json:"last_deployed_at,omitempty"`
+	// LandedAt is when the first deployment including the change finished, if it could be found
+	LandedAt           *time.Time `json:"landed_at,omitempty"`
+	LandedDeploymentID int        `json:"landed_deployment_id,omitempty"`
+	// LandingTruncated is set when the landing was not among the deployments read, but more exist
+	LandingTruncated bool `json:"landing_truncated,omitempty"`
+	// Error is why the environment could not be checked; the other environments are still traced
+	Error string `json:"error,omitempty"`
+}
+
+// changeTrace is the response of trace_change_deployment
+type changeTrace struct {
+	SHA             string             `json:"sha"`
+	Title           string             `json:"title"`
+	MergeRequestIID int                `json:"merge_request_iid,omitempty"`
+	CommittedAt     *time.Time         `json:"committed_at,omitempty"`
+	Tags            []string           `json:"tags"`
+	Environments    []environmentTrace `json:"environments"`
+	// EnvironmentsTruncated is set when the project has more available environments than listAllPages reads
+	EnvironmentsTruncated bool   `json:"environments_truncated,omitempty"`
+	Summary               string `json:"summary"`
+}
+
+// describeChangeTrace summarizes in one line which environments contain a change
+func describeChangeTrace(environments []environmentTrace) string {
+	var in, notIn, never, failed []string
+	for _, env := range environments {
+		switch {
+		case env.Error != "" && !env.Contains:
+			failed = append(failed, env.Environment)
+		case env.Contains && env.LandedAt != nil:
+			in = append(in, fmt.Sprintf("%s (since %s)", env.Environment, env.LandedAt.UTC().Format(time.RFC3339)))
+		case env.Contains:
+			in = append(in, env.Environment)
+		case env.LastDeploymentID == 0:
+			never = append(never, env.Environment)
+		default:
+			notIn = append(notIn, env.Environment)
+		}
+	}
+
+	var parts []string
+	if len(in) > 0 {
+		parts = append(parts, "deployed to "+strings.Join(in, ", "))
+	}
+	if len(notIn) > 0 {
+		parts = append(parts, "not yet in "+strings.Join(notIn, ", "))
+	}
+	if len(never) > 0 {
+		parts = append(parts, "no deployments to "+strings.Join(never, ", "))
+	}
+	if len(failed) > 0 {
+		parts = append(parts, "could not check "+strings.Join(failed, ", "))
+	}
+	if len(parts) == 0 {
+		return "the project has no available environments"
+	}
+	return strings.Join(parts, "; ")
+}
+
+// changeContainment checks whether commits contain a change, caching merge base lookups per commit
+type changeContainment struct {
+	client *gitlab.Client
+	pid    string
+	sha    string
+	cache  map[string]bool
+}
+
+// containedIn reports whether commit is the change or a descendant of it,
+// which is the case when the merge base of the two is the change itself
+func (c *changeContainment) containedIn(commit string) (bool, error) {
+	if commit == c.sha {
+		return true, nil
+	}
+	if contains, ok := c.cache[commit]; ok {
+		return contains, nil
+	}
+	base, _, err := c.client.Repositories.MergeBase(c.pid, &gitlab.MergeBaseOptions{Ref: &[]string{c.sha, commit}})
+	if err != nil {
+		return false, fmt.Errorf("failed to get merge base of %s and %s: %w", c.sha, commit, err)
+	}
+	c.cache[commit] = base.ID == c.sha
+	return c.cache[commit], nil
+}
+
+// deploymentFinishedAt is when a deployment's job finished, falling back to when the deployment was last updated
+func deploymentFinishedAt(deployment *gitlab.Deployment) *time.Time {
+	if deployment.Deployable.FinishedAt != nil {
+		return deployment.Deployable.FinishedAt
+	}
+	return deployment.UpdatedAt
+}
+
+// findLanding returns the first successful deployment to an environment that includes the change.
+// Deployments finished after the change was committed are read oldest first, page by page, up to maxListPages
+// pages. When none of those include the change and more exist, it returns no deployment and truncated set.
+func findLanding(containment *changeContainment, environment string, committedAt *time.Time) (landing *gitlab.Deployment, truncated bool, err error) {
+	opts := &gitlab.ListProjectDeploymentsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		Environment: gitlab.Ptr(environment),
+		Status:      gitlab.Ptr("success"),
+		OrderBy:     gitlab.Ptr("finished_at"),
+		Sort:        gitlab.Ptr("asc"),
+	}
+	if committedAt != nil {
+		opts.FinishedAfter = committedAt
+	}
+
+	for page := 0; page < maxListPages; page++ {
+		deployments, resp, err := containment.client.Deployments.ListProjectDeployments(containment.pid, opts)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list deployments to %s: %w", environment, err)
+		}
+		for _, deployment := range deployments {
+			contains, err := containment.containedIn(deployment.SHA)
+			if err != nil {
+				return nil, false, err
+			}
+			if contains {
+				return deployment, false, nil
+			}
+		}
+		if !morePages(resp) {
+			return nil, false, nil
+		}
+		opts.Page = resp.NextPage
+	}
+	return nil, true, nil
+}
+
+// TraceChangeDeployment returns a tool for finding which environments a merge request or commit is deployed to
+func TraceChangeDeployment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"trace_change_deployment",
+		mcp.WithDescription(t("TOOL_TRACE_CHANGE_DEPLOYMENT_DESCRIPTION", "Find which environments of a project contain a merged merge request or a commit, and when the change landed in each. Give either merge_request_id or sha")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("merge_request_id",
+			mcp.Description(t("PARAM_TRACE_MERGE_REQUEST_ID_DESCRIPTION", "The ID of a merged merge request; its merge or squash commit is traced")),
+		),
+		mcp.WithString("sha",
+			mcp.Description(t("PARAM_TRACE_SHA_DESCRIPTION", "The SHA of the commit to trace")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		mrID, err := OptionalInt(r, "merge_request_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		sha, err := OptionalParam[string](r, "sha")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if (mrID == 0) == (sha == "") {
+			return mcp.NewToolResultError("exactly one of merge_request_id and sha is required"), nil
+		}
+		pid := fmt.Sprintf("%s/%s", namespace, project)
+
+		trace := changeTrace{MergeRequestIID: mrID, Tags: []string{}, Environments: []environmentTrace{}}
+		if mrID != 0 {
+			mr, _, err := client.MergeRequests.GetMergeRequest(pid, mrID, nil)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to get merge request: %w", err).Error()), nil
+			}
+			if mr.State != "merged" {
+				return mcp.NewToolResultError(fmt.Sprintf("merge request !%d is %s, not merged", mrID, mr.State)), nil
+			}
+			// fast-forward merges have no merge commit, the head of the merge request is what landed
+			switch {
+			case mr.MergeCommitSHA != "":
+				sha = mr.MergeCommitSHA
+			case mr.SquashCommitSHA != "":
+				sha = mr.SquashCommitSHA
+			default:
+				sha = mr.SHA
+			}
+		}
+
+		commit, _, err := client.Commits.GetCommit(pid, sha, nil)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get commit: %w", err).Error()), nil
+		}
+		trace.SHA = commit.ID
+		trace.Title = commit.Title
+		trace.CommittedAt = commit.CommittedDate
+
+		refs, _, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.CommitRef, *gitlab.Response, error) {
+			return client.Commits.GetCommitRefs(pid, commit.ID, &gitlab.GetCommitRefsOptions{ListOptions: opts, Type: gitlab.Ptr("tag")})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get commit refs: %w", err).Error()), nil
+		}
+		for _, ref := range refs {
+			trace.Tags = append(trace.Tags, ref.Name)
+		}
+
+		available, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.Environment, *gitlab.Response, error) {
+			return client.Environments.ListEnvironments(pid, &gitlab.ListEnvironmentsOptions{ListOptions: opts, States: gitlab.Ptr("available")})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list environments: %w", err).Error()), nil
+		}
+		trace.EnvironmentsTruncated = morePages(resp)
+		environments, err := withLastDeployment(client, pid, available)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		containment := &changeContainment{client: client, pid: pid, sha: commit.ID, cache: map[string]bool{}}
+		for _, environment := range environments {
+			envTrace := environmentTrace{Environment: environment.Name}
+			if last := environment.LastDeployment; last != nil {
+				envTrace.LastDeploymentID = last.ID
+				envTrace.LastDeployedSHA = last.SHA
+				envTrace.LastDeployedAt = deploymentFinishedAt(last)
+				if envTrace.Contains, err = containment.containedIn(last.SHA); err != nil {
+					envTrace.Error = err.Error()
+				}
+			}
+			if envTrace.Contains {
+				landing, truncated, err := findLanding(containment, environment.Name, commit.CommittedDate)
+				switch {
+				case err != nil:
+					envTrace.Error = err.Error()
+				case landing != nil:
+					envTrace.LandedAt = deploymentFinishedAt(landing)
+					envTrace.LandedDeploymentID = landing.ID
+				default:
+					envTrace.LandingTruncated = truncated
+				}
+			}
+			trace.Environments = append(trace.Environments, envTrace)
+		}
+		trace.Summary = describeChangeTrace(trace.Environments)
+
+		jsonData, err := json.Marshal(trace)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
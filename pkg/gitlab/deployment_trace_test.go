@@ -0,0 +1,235 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockRepositoriesService is a mock implementation of the GitLab repositories service
+type mockRepositoriesService struct {
+	mergeBaseFunc func(pid interface{}, opt *gitlab.MergeBaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error)
+}
+
+// ensure mockRepositoriesService implements the gitlab.RepositoriesServiceInterface
+var _ gitlab.RepositoriesServiceInterface = &mockRepositoriesService{}
+
+func (m *mockRepositoriesService) MergeBase(pid interface{}, opt *gitlab.MergeBaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+	return m.mergeBaseFunc(pid, opt, options...)
+}
+
+func (m *mockRepositoriesService) AddChangelog(pid interface{}, opt *gitlab.AddChangelogOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockRepositoriesService) Archive(pid interface{}, opt *gitlab.ArchiveOptions, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoriesService) Blob(pid interface{}, sha string, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoriesService) Compare(pid interface{}, opt *gitlab.CompareOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Compare, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoriesService) Contributors(pid interface{}, opt *gitlab.ListContributorsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Contributor, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoriesService) GenerateChangelogData(pid interface{}, opt gitlab.GenerateChangelogDataOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ChangelogData, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoriesService) ListTree(pid interface{}, opt *gitlab.ListTreeOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.TreeNode, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoriesService) RawBlobContent(pid interface{}, sha string, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRepositoriesService) StreamArchive(pid interface{}, w io.Writer, opt *gitlab.ArchiveOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func TestTraceChangeDeployment(t *testing.T) {
+	committedAt := time.Date(2024, 5, 6, 9, 0, 0, 0, time.UTC)
+	stagedAt := time.Date(2024, 5, 6, 11, 0, 0, 0, time.UTC)
+	releasedAt := time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC)
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mrState       string
+		failingBase   string
+		expected      *changeTrace
+		expectedError string
+	}{
+		{
+			name: "merged merge request traced through environments",
+			args: map[string]interface{}{
+				"namespace":        "group",
+				"project":          "project",
+				"merge_request_id": float64(42),
+			},
+			mrState: "merged",
+			expected: &changeTrace{
+				SHA:             "m42",
+				Title:           "Merge branch 'fix-login'",
+				MergeRequestIID: 42,
+				CommittedAt:     &committedAt,
+				Tags:            []string{},
+				Environments: []environmentTrace{
+					{Environment: "staging", Contains: true, LastDeploymentID: 12, LastDeployedSHA: "s2", LastDeployedAt: &stagedAt, LandedAt: &stagedAt, LandedDeploymentID: 12},
+					{Environment: "production", LastDeploymentID: 5, LastDeployedSHA: "p1", LastDeployedAt: &releasedAt},
+					{Environment: "review/feature"},
+				},
+				Summary: "deployed to staging (since 2024-05-06T11:00:00Z); not yet in production; no deployments to review/feature",
+			},
+		},
+		{
+			name: "merge base failure is kept on its environment",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"sha":       "m42",
+			},
+			failingBase: "p1",
+			expected: &changeTrace{
+				SHA:         "m42",
+				Title:       "Merge branch 'fix-login'",
+				CommittedAt: &committedAt,
+				Tags:        []string{},
+				Environments: []environmentTrace{
+					{Environment: "staging", Contains: true, LastDeploymentID: 12, LastDeployedSHA: "s2", LastDeployedAt: &stagedAt, LandedAt: &stagedAt, LandedDeploymentID: 12},
+					{Environment: "production", LastDeploymentID: 5, LastDeployedSHA: "p1", LastDeployedAt: &releasedAt, Error: "failed to get merge base of m42 and p1: 500 Internal Server Error"},
+					{Environment: "review/feature"},
+				},
+				Summary: "deployed to staging (since 2024-05-06T11:00:00Z); no deployments to review/feature; could not check production",
+			},
+		},
+		{
+			name: "open merge request",
+			args: map[string]interface{}{
+				"namespace":        "group",
+				"project":          "project",
+				"merge_request_id": float64(42),
+			},
+			mrState:       "opened",
+			expectedError: "merge request !42 is opened, not merged",
+		},
+		{
+			name: "both merge request and sha",
+			args: map[string]interface{}{
+				"namespace":        "group",
+				"project":          "project",
+				"merge_request_id": float64(42),
+				"sha":              "m42",
+			},
+			expectedError: "exactly one of merge_request_id and sha is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			staging := &gitlab.Deployment{ID: 12, SHA: "s2"}
+			staging.Deployable.FinishedAt = &stagedAt
+			production := &gitlab.Deployment{ID: 5, SHA: "p1"}
+			production.Deployable.FinishedAt = &releasedAt
+			earlierStaging := &gitlab.Deployment{ID: 11, SHA: "s1"}
+
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					MergeRequests: &mockMergeRequestsService{
+						getFunc: func(pid interface{}, mr int, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+							merged := &gitlab.MergeRequest{}
+							merged.MergeCommitSHA = "m42"
+							merged.State = tc.mrState
+							return merged, ok, nil
+						},
+					},
+					Commits: &mockCommitsService{
+						getFunc: func(pid interface{}, sha string, opt *gitlab.GetCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+							assert.Equal(t, "m42", sha)
+							return &gitlab.Commit{ID: "m42", Title: "Merge branch 'fix-login'", CommittedDate: &committedAt}, ok, nil
+						},
+						getRefsFunc: func(pid interface{}, sha string, opt *gitlab.GetCommitRefsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.CommitRef, *gitlab.Response, error) {
+							assert.Equal(t, "tag", *opt.Type)
+							return nil, ok, nil
+						},
+					},
+					Environments: &mockEnvironmentsService{
+						listFunc: func(pid interface{}, opts *gitlab.ListEnvironmentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Environment, *gitlab.Response, error) {
+							return []*gitlab.Environment{{ID: 1}, {ID: 2}, {ID: 3}}, ok, nil
+						},
+						getFunc: func(pid interface{}, environment int, options ...gitlab.RequestOptionFunc) (*gitlab.Environment, *gitlab.Response, error) {
+							switch environment {
+							case 1:
+								return &gitlab.Environment{ID: 1, Name: "staging", LastDeployment: staging}, ok, nil
+							case 2:
+								return &gitlab.Environment{ID: 2, Name: "production", LastDeployment: production}, ok, nil
+							default:
+								return &gitlab.Environment{ID: 3, Name: "review/feature"}, ok, nil
+							}
+						},
+					},
+					Deployments: &mockDeploymentsService{
+						listFunc: func(pid interface{}, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Deployment, *gitlab.Response, error) {
+							assert.Equal(t, "staging", *opts.Environment)
+							assert.Equal(t, &committedAt, opts.FinishedAfter)
+							// the landing is on the second page
+							if opts.Page == 0 {
+								return []*gitlab.Deployment{earlierStaging}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}, NextPage: 2}, nil
+							}
+							return []*gitlab.Deployment{staging}, ok, nil
+						},
+					},
+					Repositories: &mockRepositoriesService{
+						mergeBaseFunc: func(pid interface{}, opt *gitlab.MergeBaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+							refs := *opt.Ref
+							assert.Equal(t, "m42", refs[0])
+							if refs[1] == tc.failingBase {
+								return nil, nil, fmt.Errorf("500 Internal Server Error")
+							}
+							if refs[1] == "s2" {
+								return &gitlab.Commit{ID: "m42"}, ok, nil
+							}
+							return &gitlab.Commit{ID: "older"}, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := TraceChangeDeployment(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got changeTrace
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, *tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package gitlab
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// defaultMaxDiffBytes is the default amount of diff text returned from a single tool call.
+// Large diffs quickly exhaust a model's context window, so anything beyond this is truncated.
+const defaultMaxDiffBytes = 50000
+
+// truncatedDiffMarker is appended to a diff that has been cut to fit the budget
+const truncatedDiffMarker = "\n... diff truncated ..."
+
+// withMaxDiffBytes adds the optional max_diff_bytes parameter to a tool
+func withMaxDiffBytes(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return mcp.WithNumber("max_diff_bytes",
+		mcp.Description(t("PARAM_MAX_DIFF_BYTES_DESCRIPTION", fmt.Sprintf("Maximum total size of diff text to return (default %d)", defaultMaxDiffBytes))),
+		mcp.Min(0),
+	)
+}
+
+// optionalMaxDiffBytes returns the max_diff_bytes parameter from the request, falling back to the default
+func optionalMaxDiffBytes(r mcp.CallToolRequest) (int, error) {
+	maxBytes, err := OptionalInt(r, "max_diff_bytes")
+	if err != nil {
+		return 0, err
+	}
+	if maxBytes <= 0 {
+		return defaultMaxDiffBytes, nil
+	}
+	return maxBytes, nil
+}
+
+// budgetedDiffs is the size-limited form of a list of file diffs
+type budgetedDiffs struct {
+	Diffs []*gitlab.Diff `json:"diffs"`
+	// TruncatedFiles lists the files whose diff was cut short or dropped to stay within the budget
+	TruncatedFiles []string `json:"truncated_files,omitempty"`
+	// FilesTruncated is set when GitLab has more changed files than were read, so Diffs does not list every changed path
+	FilesTruncated bool `json:"files_truncated,omitempty"`
+}
+
+// budgetDiffs limits the total diff text to maxBytes. Every given file is kept so that the list of
+// changed paths is complete, but once the budget is spent the remaining diffs are emptied.
+// The input diffs are not modified.
+func budgetDiffs(diffs []*gitlab.Diff, maxBytes int) budgetedDiffs {
+	result := budgetedDiffs{Diffs: make([]*gitlab.Diff, 0, len(diffs))}
+	remaining := maxBytes
+
+	for _, d := range diffs {
+		if d == nil {
+			continue
+		}
+		diff := *d
+		if len(diff.Diff) > remaining {
+			if remaining > 0 {
+				// cut at the start of a rune so the diff stays valid UTF-8
+				end := remaining
+				for end > 0 && !utf8.RuneStart(diff.Diff[end]) {
+					end--
+				}
+				diff.Diff = diff.Diff[:end] + truncatedDiffMarker
+			} else {
+				diff.Diff = ""
+			}
+			result.TruncatedFiles = append(result.TruncatedFiles, diff.NewPath)
+			remaining = 0
+		} else {
+			remaining -= len(diff.Diff)
+		}
+		result.Diffs = append(result.Diffs, &diff)
+	}
+
+	return result
+}
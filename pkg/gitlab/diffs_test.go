@@ -0,0 +1,68 @@
+package gitlab
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestBudgetDiffs(t *testing.T) {
+	diffs := []*gitlab.Diff{
+		{NewPath: "a.go", Diff: "aaaaa"},
+		{NewPath: "b.go", Diff: "bbbbb"},
+		{NewPath: "c.go", Diff: "ccccc"},
+	}
+
+	tests := []struct {
+		name              string
+		maxBytes          int
+		expectedDiffs     []string
+		expectedTruncated []string
+	}{
+		{
+			name:          "within budget",
+			maxBytes:      100,
+			expectedDiffs: []string{"aaaaa", "bbbbb", "ccccc"},
+		},
+		{
+			name:              "truncated in the middle of a file",
+			maxBytes:          7,
+			expectedDiffs:     []string{"aaaaa", "bb" + truncatedDiffMarker, ""},
+			expectedTruncated: []string{"b.go", "c.go"},
+		},
+		{
+			name:              "budget ends on a file boundary",
+			maxBytes:          5,
+			expectedDiffs:     []string{"aaaaa", "", ""},
+			expectedTruncated: []string{"b.go", "c.go"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := budgetDiffs(diffs, tc.maxBytes)
+
+			got := make([]string, 0, len(result.Diffs))
+			for _, d := range result.Diffs {
+				got = append(got, d.Diff)
+			}
+			assert.Equal(t, tc.expectedDiffs, got)
+			assert.Equal(t, tc.expectedTruncated, result.TruncatedFiles)
+
+			// the input must be left untouched
+			assert.Equal(t, "bbbbb", diffs[1].Diff)
+		})
+	}
+}
+
+func TestBudgetDiffsKeepsRunesWhole(t *testing.T) {
+	// é is two bytes, so a budget of 2 falls in the middle of it
+	result := budgetDiffs([]*gitlab.Diff{{NewPath: "a.txt", Diff: "héllo"}}, 2)
+
+	require.Len(t, result.Diffs, 1)
+	assert.Equal(t, "h"+truncatedDiffMarker, result.Diffs[0].Diff)
+	assert.True(t, utf8.ValidString(result.Diffs[0].Diff))
+}
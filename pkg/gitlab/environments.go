@@ -0,0 +1,433 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// deploymentSummary is a deployment with the fields needed to tell what was deployed where and when
+type deploymentSummary struct {
+	ID          int        `json:"id"`
+	IID         int        `json:"iid"`
+	Environment string     `json:"environment,omitempty"`
+	Ref         string     `json:"ref"`
+	SHA         string     `json:"sha"`
+	Status      string     `json:"status"`
+	User        string     `json:"user,omitempty"`
+	Job         string     `json:"job,omitempty"`
+	PipelineID  int        `json:"pipeline_id,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+}
+
+// summarizeDeployment flattens a deployment
+func summarizeDeployment(deployment *gitlab.Deployment) *deploymentSummary {
+	summary := &deploymentSummary{
+		ID:         deployment.ID,
+		IID:        deployment.IID,
+		Ref:        deployment.Ref,
+		SHA:        deployment.SHA,
+		Status:     deployment.Status,
+		Job:        deployment.Deployable.Name,
+		PipelineID: deployment.Deployable.Pipeline.ID,
+		CreatedAt:  deployment.CreatedAt,
+		FinishedAt: deployment.Deployable.FinishedAt,
+	}
+	if deployment.Environment != nil {
+		summary.Environment = deployment.Environment.Name
+	}
+	if deployment.User != nil {
+		summary.User = deployment.User.Username
+	}
+	return summary
+}
+
+// environmentSummary is an environment with its last deployment
+type environmentSummary struct {
+	ID             int                `json:"id"`
+	Name           string             `json:"name"`
+	State          string             `json:"state"`
+	Tier           string             `json:"tier,omitempty"`
+	ExternalURL    string             `json:"external_url,omitempty"`
+	AutoStopAt     *time.Time         `json:"auto_stop_at,omitempty"`
+	LastDeployment *deploymentSummary `json:"last_deployment,omitempty"`
+}
+
+// summarizeEnvironment flattens an environment
+func summarizeEnvironment(environment *gitlab.Environment) environmentSummary {
+	summary := environmentSummary{
+		ID:          environment.ID,
+		Name:        environment.Name,
+		State:       environment.State,
+		Tier:        environment.Tier,
+		ExternalURL: environment.ExternalURL,
+		AutoStopAt:  environment.AutoStopAt,
+	}
+	if environment.LastDeployment != nil {
+		summary.LastDeployment = summarizeDeployment(environment.LastDeployment)
+		// the environment of the last deployment is the environment itself
+		summary.LastDeployment.Environment = ""
+	}
+	return summary
+}
+
+// deploymentCommit is the commit a deployment deployed
+type deploymentCommit struct {
+	ID         string     `json:"id"`
+	Title      string     `json:"title"`
+	AuthorName string     `json:"author_name"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// deploymentMergeRequest is a merge request shipped by a deployment
+type deploymentMergeRequest struct {
+	IID      int        `json:"iid"`
+	Title    string     `json:"title"`
+	Author   string     `json:"author,omitempty"`
+	MergedAt *time.Time `json:"merged_at,omitempty"`
+	WebURL   string     `json:"web_url"`
+}
+
+// deploymentDetails is the response of get_deployment
+type deploymentDetails struct {
+	deploymentSummary
+	Commit        *deploymentCommit        `json:"commit,omitempty"`
+	MergeRequests []deploymentMergeRequest `json:"merge_requests"`
+	// MergeRequestsTruncated is set when the deployment has more merge requests than listAllPages reads
+	MergeRequestsTruncated bool `json:"merge_requests_truncated,omitempty"`
+}
+
+// listEnvironmentsWithLastDeployment lists one page of environments with their last deployment
+func listEnvironmentsWithLastDeployment(client *gitlab.Client, pid string, opts *gitlab.ListEnvironmentsOptions) ([]*gitlab.Environment, error) {
+	environments, _, err := client.Environments.ListEnvironments(pid, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+	return withLastDeployment(client, pid, environments)
+}
+
+// withLastDeployment gets each environment again, as the list endpoint does not include the last deployment
+func withLastDeployment(client *gitlab.Client, pid string, environments []*gitlab.Environment) ([]*gitlab.Environment, error) {
+	detailed := make([]*gitlab.Environment, 0, len(environments))
+	for _, environment := range environments {
+		environment, _, err := client.Environments.GetEnvironment(pid, environment.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get environment: %w", err)
+		}
+		detailed = append(detailed, environment)
+	}
+	return detailed, nil
+}
+
+// ListEnvironments returns a tool for listing the environments of a project with their last deployment
+func ListEnvironments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_environments",
+		mcp.WithDescription(t("TOOL_LIST_ENVIRONMENTS_DESCRIPTION", "List the environments of a project with the ref, commit and time of their last successful deployment")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("search",
+			mcp.Description(t("PARAM_ENVIRONMENT_SEARCH_DESCRIPTION", "Only return environments whose name contains this text")),
+		),
+		mcp.WithString("states",
+			mcp.Description(t("PARAM_ENVIRONMENT_STATES_DESCRIPTION", "Only return environments in this state")),
+			mcp.Enum("available", "stopping", "stopped"),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		search, err := OptionalParam[string](r, "search")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		states, err := OptionalParam[string](r, "states")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pid := fmt.Sprintf("%s/%s", namespace, project)
+
+		opts := &gitlab.ListEnvironmentsOptions{ListOptions: pagination}
+		if search != "" {
+			opts.Search = gitlab.Ptr(search)
+		}
+		if states != "" {
+			opts.States = gitlab.Ptr(states)
+		}
+
+		environments, err := listEnvironmentsWithLastDeployment(client, pid, opts)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		summaries := make([]environmentSummary, 0, len(environments))
+		for _, environment := range environments {
+			summaries = append(summaries, summarizeEnvironment(environment))
+		}
+
+		jsonData, err := json.Marshal(summaries)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// ListDeployments returns a tool for listing the deployments of a project, newest first
+func ListDeployments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_deployments",
+		mcp.WithDescription(t("TOOL_LIST_DEPLOYMENTS_DESCRIPTION", "List the deployments of a project, newest first")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("environment",
+			mcp.Description(t("PARAM_DEPLOYMENT_ENVIRONMENT_DESCRIPTION", "Only return deployments to the environment with this name")),
+		),
+		mcp.WithString("status",
+			mcp.Description(t("PARAM_DEPLOYMENT_STATUS_DESCRIPTION", "Only return deployments with this status")),
+			mcp.Enum("created", "running", "success", "failed", "canceled", "blocked"),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		environment, err := OptionalParam[string](r, "environment")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		status, err := OptionalParam[string](r, "status")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.ListProjectDeploymentsOptions{
+			ListOptions: pagination,
+			OrderBy:     gitlab.Ptr("id"),
+			Sort:        gitlab.Ptr("desc"),
+		}
+		if environment != "" {
+			opts.Environment = gitlab.Ptr(environment)
+		}
+		if status != "" {
+			opts.Status = gitlab.Ptr(status)
+		}
+
+		deployments, _, err := client.Deployments.ListProjectDeployments(fmt.Sprintf("%s/%s", namespace, project), opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list deployments: %w", err).Error()), nil
+		}
+
+		summaries := make([]*deploymentSummary, 0, len(deployments))
+		for _, deployment := range deployments {
+			summaries = append(summaries, summarizeDeployment(deployment))
+		}
+
+		jsonData, err := json.Marshal(summaries)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// GetDeployment returns a tool for getting a deployment with its commit and merge requests
+func GetDeployment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_deployment",
+		mcp.WithDescription(t("TOOL_GET_DEPLOYMENT_DESCRIPTION", "Get a deployment with the commit it deployed and the merge requests it shipped")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("deployment_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_DEPLOYMENT_ID_DESCRIPTION", "The ID of the deployment")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		deploymentID, err := RequiredInt(r, "deployment_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pid := fmt.Sprintf("%s/%s", namespace, project)
+
+		deployment, _, err := client.Deployments.GetProjectDeployment(pid, deploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get deployment: %w", err).Error()), nil
+		}
+		mergeRequests, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+			return client.DeploymentMergeRequests.ListDeploymentMergeRequests(pid, deploymentID, &gitlab.ListMergeRequestsOptions{ListOptions: opts})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list deployment merge requests: %w", err).Error()), nil
+		}
+
+		details := deploymentDetails{
+			deploymentSummary:      *summarizeDeployment(deployment),
+			MergeRequests:          make([]deploymentMergeRequest, 0, len(mergeRequests)),
+			MergeRequestsTruncated: morePages(resp),
+		}
+		if commit := deployment.Deployable.Commit; commit != nil {
+			details.Commit = &deploymentCommit{ID: commit.ID, Title: commit.Title, AuthorName: commit.AuthorName, CreatedAt: commit.CreatedAt}
+		}
+		for _, mr := range mergeRequests {
+			merged := deploymentMergeRequest{IID: mr.IID, Title: mr.Title, MergedAt: mr.MergedAt, WebURL: mr.WebURL}
+			if mr.Author != nil {
+				merged.Author = mr.Author.Username
+			}
+			details.MergeRequests = append(details.MergeRequests, merged)
+		}
+
+		jsonData, err := json.Marshal(details)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// StopEnvironment returns a tool for stopping an environment
+func StopEnvironment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"stop_environment",
+		mcp.WithDescription(t("TOOL_STOP_ENVIRONMENT_DESCRIPTION", "Stop an environment, running its on_stop job if it has one")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("environment_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ENVIRONMENT_ID_DESCRIPTION", "The ID of the environment, as returned by list_environments")),
+		),
+		mcp.WithBoolean("force",
+			mcp.Description(t("PARAM_STOP_ENVIRONMENT_FORCE_DESCRIPTION", "Stop the environment without running its on_stop job")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		environmentID, err := RequiredInt(r, "environment_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		force, err := OptionalParam[bool](r, "force")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.StopEnvironmentOptions{}
+		if force {
+			opts.Force = gitlab.Ptr(true)
+		}
+
+		environment, _, err := client.Environments.StopEnvironment(fmt.Sprintf("%s/%s", namespace, project), environmentID, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to stop environment: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(summarizeEnvironment(environment))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
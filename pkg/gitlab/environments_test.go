@@ -0,0 +1,447 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockEnvironmentsService is a mock implementation of the GitLab environments service
+type mockEnvironmentsService struct {
+	listFunc func(pid interface{}, opts *gitlab.ListEnvironmentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Environment, *gitlab.Response, error)
+	getFunc  func(pid interface{}, environment int, options ...gitlab.RequestOptionFunc) (*gitlab.Environment, *gitlab.Response, error)
+	stopFunc func(pid interface{}, environmentID int, opt *gitlab.StopEnvironmentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Environment, *gitlab.Response, error)
+}
+
+// ensure mockEnvironmentsService implements the gitlab.EnvironmentsServiceInterface
+var _ gitlab.EnvironmentsServiceInterface = &mockEnvironmentsService{}
+
+func (m *mockEnvironmentsService) ListEnvironments(pid interface{}, opts *gitlab.ListEnvironmentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Environment, *gitlab.Response, error) {
+	return m.listFunc(pid, opts, options...)
+}
+
+func (m *mockEnvironmentsService) GetEnvironment(pid interface{}, environment int, options ...gitlab.RequestOptionFunc) (*gitlab.Environment, *gitlab.Response, error) {
+	return m.getFunc(pid, environment, options...)
+}
+
+func (m *mockEnvironmentsService) CreateEnvironment(pid interface{}, opt *gitlab.CreateEnvironmentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Environment, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockEnvironmentsService) DeleteEnvironment(pid interface{}, environment int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockEnvironmentsService) EditEnvironment(pid interface{}, environment int, opt *gitlab.EditEnvironmentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Environment, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockEnvironmentsService) StopEnvironment(pid interface{}, environmentID int, opt *gitlab.StopEnvironmentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Environment, *gitlab.Response, error) {
+	return m.stopFunc(pid, environmentID, opt, options...)
+}
+
+// mockDeploymentsService is a mock implementation of the GitLab deployments service
+type mockDeploymentsService struct {
+	getFunc  func(pid interface{}, deployment int, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error)
+	listFunc func(pid interface{}, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Deployment, *gitlab.Response, error)
+}
+
+// ensure mockDeploymentsService implements the gitlab.DeploymentsServiceInterface
+var _ gitlab.DeploymentsServiceInterface = &mockDeploymentsService{}
+
+func (m *mockDeploymentsService) GetProjectDeployment(pid interface{}, deployment int, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error) {
+	return m.getFunc(pid, deployment, options...)
+}
+
+func (m *mockDeploymentsService) ListProjectDeployments(pid interface{}, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Deployment, *gitlab.Response, error) {
+	return m.listFunc(pid, opts, options...)
+}
+
+func (m *mockDeploymentsService) ApproveOrRejectProjectDeployment(pid interface{}, deployment int, opt *gitlab.ApproveOrRejectProjectDeploymentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockDeploymentsService) CreateProjectDeployment(pid interface{}, opt *gitlab.CreateProjectDeploymentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockDeploymentsService) DeleteProjectDeployment(pid interface{}, deployment int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockDeploymentsService) UpdateProjectDeployment(pid interface{}, deployment int, opt *gitlab.UpdateProjectDeploymentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+// mockDeploymentMergeRequestsService is a mock implementation of the GitLab deployment merge requests service
+type mockDeploymentMergeRequestsService struct {
+	listFunc func(pid interface{}, deployment int, opts *gitlab.ListMergeRequestsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.MergeRequest, *gitlab.Response, error)
+}
+
+// ensure mockDeploymentMergeRequestsService implements the gitlab.DeploymentMergeRequestsServiceInterface
+var _ gitlab.DeploymentMergeRequestsServiceInterface = &mockDeploymentMergeRequestsService{}
+
+func (m *mockDeploymentMergeRequestsService) ListDeploymentMergeRequests(pid interface{}, deployment int, opts *gitlab.ListMergeRequestsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+	return m.listFunc(pid, deployment, opts, options...)
+}
+
+func TestListEnvironments(t *testing.T) {
+	deployedAt := time.Date(2024, 5, 6, 10, 0, 0, 0, time.UTC)
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		getError      error
+		expected      []environmentSummary
+		expectedError string
+	}{
+		{
+			name: "environments with last deployment",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"states":    "available",
+			},
+			expected: []environmentSummary{
+				{
+					ID:    1,
+					Name:  "staging",
+					State: "available",
+					LastDeployment: &deploymentSummary{
+						ID: 10, IID: 3, Ref: "main", SHA: "abc123", Status: "success", User: "alice", CreatedAt: &deployedAt,
+					},
+				},
+				{ID: 2, Name: "review/feature", State: "available"},
+			},
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+			},
+			getError:      fmt.Errorf("API error"),
+			expectedError: "failed to get environment: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Environments: &mockEnvironmentsService{
+						listFunc: func(pid interface{}, opts *gitlab.ListEnvironmentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Environment, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							return []*gitlab.Environment{{ID: 1, Name: "staging"}, {ID: 2, Name: "review/feature"}}, ok, nil
+						},
+						getFunc: func(pid interface{}, environment int, options ...gitlab.RequestOptionFunc) (*gitlab.Environment, *gitlab.Response, error) {
+							if environment == 1 {
+								deployment := &gitlab.Deployment{
+									ID: 10, IID: 3, Ref: "main", SHA: "abc123", Status: "success", CreatedAt: &deployedAt,
+									User:        &gitlab.ProjectUser{Username: "alice"},
+									Environment: &gitlab.Environment{Name: "staging"},
+								}
+								return &gitlab.Environment{ID: 1, Name: "staging", State: "available", LastDeployment: deployment}, ok, tc.getError
+							}
+							return &gitlab.Environment{ID: 2, Name: "review/feature", State: "available"}, ok, tc.getError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListEnvironments(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got []environmentSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestListDeployments(t *testing.T) {
+	deployedAt := time.Date(2024, 5, 6, 10, 0, 0, 0, time.UTC)
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name                string
+		args                map[string]interface{}
+		mockError           error
+		expectedEnvironment *string
+		expectedStatus      *string
+		expected            []*deploymentSummary
+		expectedError       string
+	}{
+		{
+			name: "deployments newest first",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+			},
+			expected: []*deploymentSummary{
+				{ID: 11, IID: 4, Environment: "production", Ref: "main", SHA: "def456", Status: "success", User: "alice", Job: "deploy", PipelineID: 30, CreatedAt: &deployedAt},
+			},
+		},
+		{
+			name: "filtered by environment and status",
+			args: map[string]interface{}{
+				"namespace":   "group",
+				"project":     "project",
+				"environment": "production",
+				"status":      "success",
+			},
+			expectedEnvironment: gitlab.Ptr("production"),
+			expectedStatus:      gitlab.Ptr("success"),
+			expected: []*deploymentSummary{
+				{ID: 11, IID: 4, Environment: "production", Ref: "main", SHA: "def456", Status: "success", User: "alice", Job: "deploy", PipelineID: 30, CreatedAt: &deployedAt},
+			},
+		},
+		{
+			name: "missing project",
+			args: map[string]interface{}{
+				"namespace": "group",
+			},
+			expectedError: "missing required parameter: project",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+			},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to list deployments: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Deployments: &mockDeploymentsService{
+						listFunc: func(pid interface{}, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Deployment, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, gitlab.Ptr("id"), opts.OrderBy)
+							assert.Equal(t, gitlab.Ptr("desc"), opts.Sort)
+							assert.Equal(t, tc.expectedEnvironment, opts.Environment)
+							assert.Equal(t, tc.expectedStatus, opts.Status)
+							if tc.mockError != nil {
+								return nil, nil, tc.mockError
+							}
+							deployment := &gitlab.Deployment{
+								ID: 11, IID: 4, Ref: "main", SHA: "def456", Status: "success", CreatedAt: &deployedAt,
+								User:        &gitlab.ProjectUser{Username: "alice"},
+								Environment: &gitlab.Environment{Name: "production"},
+							}
+							deployment.Deployable.Name = "deploy"
+							deployment.Deployable.Pipeline.ID = 30
+							return []*gitlab.Deployment{deployment}, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListDeployments(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got []*deploymentSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestGetDeployment(t *testing.T) {
+	mergedAt := time.Date(2024, 5, 5, 16, 0, 0, 0, time.UTC)
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	deployment := &gitlab.Deployment{ID: 10, IID: 3, Ref: "main", SHA: "abc123", Status: "success", Environment: &gitlab.Environment{Name: "staging"}}
+	deployment.Deployable.Name = "deploy:staging"
+	deployment.Deployable.Pipeline.ID = 77
+	deployment.Deployable.Commit = &gitlab.Commit{ID: "abc123", Title: "Merge branch 'fix-login'", AuthorName: "Alice"}
+
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return &gitlab.Client{
+			Deployments: &mockDeploymentsService{
+				getFunc: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Deployment, *gitlab.Response, error) {
+					assert.Equal(t, "group/project", pid)
+					assert.Equal(t, 10, id)
+					return deployment, ok, nil
+				},
+			},
+			DeploymentMergeRequests: &mockDeploymentMergeRequestsService{
+				listFunc: func(pid interface{}, id int, opts *gitlab.ListMergeRequestsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+					mr := &gitlab.MergeRequest{}
+					mr.IID = 42
+					mr.Title = "Fix login"
+					mr.MergedAt = &mergedAt
+					mr.WebURL = "https://gitlab.example.com/group/project/-/merge_requests/42"
+					mr.Author = &gitlab.BasicUser{Username: "bob"}
+					return []*gitlab.MergeRequest{mr}, ok, nil
+				},
+			},
+		}, nil
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := GetDeployment(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"namespace":     "group",
+		"project":       "project",
+		"deployment_id": float64(10),
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var got deploymentDetails
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.Equal(t, deploymentDetails{
+		deploymentSummary: deploymentSummary{ID: 10, IID: 3, Environment: "staging", Ref: "main", SHA: "abc123", Status: "success", Job: "deploy:staging", PipelineID: 77},
+		Commit:            &deploymentCommit{ID: "abc123", Title: "Merge branch 'fix-login'", AuthorName: "Alice"},
+		MergeRequests: []deploymentMergeRequest{
+			{IID: 42, Title: "Fix login", Author: "bob", MergedAt: &mergedAt, WebURL: "https://gitlab.example.com/group/project/-/merge_requests/42"},
+		},
+	}, got)
+}
+
+func TestStopEnvironment(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mockError     error
+		expectedForce *bool
+		expected      environmentSummary
+		expectedError string
+	}{
+		{
+			name: "stop running on_stop",
+			args: map[string]interface{}{
+				"namespace":      "group",
+				"project":        "project",
+				"environment_id": float64(2),
+			},
+			expected: environmentSummary{ID: 2, Name: "review/feature", State: "stopping"},
+		},
+		{
+			name: "force stop",
+			args: map[string]interface{}{
+				"namespace":      "group",
+				"project":        "project",
+				"environment_id": float64(2),
+				"force":          true,
+			},
+			expectedForce: gitlab.Ptr(true),
+			expected:      environmentSummary{ID: 2, Name: "review/feature", State: "stopping"},
+		},
+		{
+			name: "force given as a string",
+			args: map[string]interface{}{
+				"namespace":      "group",
+				"project":        "project",
+				"environment_id": float64(2),
+				"force":          "true",
+			},
+			expectedError: "parameter force is not of type bool",
+		},
+		{
+			name: "missing environment_id",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+			},
+			expectedError: "missing required parameter: environment_id",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace":      "group",
+				"project":        "project",
+				"environment_id": float64(2),
+			},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to stop environment: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Environments: &mockEnvironmentsService{
+						stopFunc: func(pid interface{}, environmentID int, opt *gitlab.StopEnvironmentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Environment, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, 2, environmentID)
+							assert.Equal(t, tc.expectedForce, opt.Force)
+							if tc.mockError != nil {
+								return nil, nil, tc.mockError
+							}
+							return &gitlab.Environment{ID: 2, Name: "review/feature", State: "stopping"}, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := StopEnvironment(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got environmentSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,569 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// epicChildren is the response of the list_epic_children tool
+type epicChildren struct {
+	Epics  []*gitlab.Epic  `json:"epics"`
+	Issues []*gitlab.Issue `json:"issues"`
+}
+
+// withEpicGroup adds the group parameter shared by the epic tools
+func withEpicGroup(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return mcp.WithString("namespace",
+		mcp.Required(),
+		mcp.Description(t("PARAM_EPIC_GROUP_DESCRIPTION", "The full path of the group containing the epics")),
+	)
+}
+
+// withEpicIID adds the epic IID parameter shared by the epic tools
+func withEpicIID(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return mcp.WithNumber("epic_iid",
+		mcp.Required(),
+		mcp.Description(t("PARAM_EPIC_IID_DESCRIPTION", "The IID of the epic, as shown in its URL")),
+	)
+}
+
+// optionalLabelOptions reads an optional array of labels into the label type used by the GitLab options
+func optionalLabelOptions(r mcp.CallToolRequest, p string) (*gitlab.LabelOptions, error) {
+	labels, err := OptionalStringArrayParam(r, p)
+	if err != nil || labels == nil {
+		return nil, err
+	}
+	return (*gitlab.LabelOptions)(&labels), nil
+}
+
+// ListEpics returns a tool for listing the epics of a group
+func ListEpics(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_epics",
+		mcp.WithDescription(t("TOOL_LIST_EPICS_DESCRIPTION", "List the epics of a group")),
+		withEpicGroup(t),
+		mcp.WithString("state",
+			mcp.Description(t("PARAM_EPIC_STATE_DESCRIPTION", "Only return epics in this state")),
+			mcp.Enum("opened", "closed", "all"),
+		),
+		mcp.WithString("search",
+			mcp.Description(t("PARAM_EPIC_SEARCH_DESCRIPTION", "Only return epics with a title or description containing this text")),
+		),
+		mcp.WithArray("labels",
+			mcp.Description(t("PARAM_EPIC_FILTER_LABELS_DESCRIPTION", "Only return epics with all of these labels")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		),
+		mcp.WithBoolean("include_descendant_groups",
+			mcp.Description(t("PARAM_EPIC_INCLUDE_DESCENDANT_GROUPS_DESCRIPTION", "Include epics of subgroups (default true)")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		group, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		labels, err := optionalLabelOptions(r, "labels")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		state, err := OptionalParam[string](r, "state")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		search, err := OptionalParam[string](r, "search")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.ListGroupEpicsOptions{
+			ListOptions: pagination,
+			Labels:      labels,
+		}
+		if state != "" {
+			opts.State = &state
+		}
+		if search != "" {
+			opts.Search = &search
+		}
+		if _, ok := r.Params.Arguments["include_descendant_groups"]; ok {
+			includeDescendants, err := OptionalParam[bool](r, "include_descendant_groups")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.IncludeDescendantGroups = &includeDescendants
+		}
+
+		epics, _, err := client.Epics.ListGroupEpics(group, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list epics: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(epics)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// GetEpic returns a tool for getting a single epic
+func GetEpic(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_epic",
+		mcp.WithDescription(t("TOOL_GET_EPIC_DESCRIPTION", "Get an epic of a group")),
+		withEpicGroup(t),
+		withEpicIID(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		group, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		epicIID, err := RequiredInt(r, "epic_iid")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		epic, _, err := client.Epics.GetEpic(group, epicIID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get epic: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(epic)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// ListEpicChildren returns a tool for listing the child epics and issues of an epic
+func ListEpicChildren(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_epic_children",
+		mcp.WithDescription(t("TOOL_LIST_EPIC_CHILDREN_DESCRIPTION", "List the child epics and the issues of an epic")),
+		withEpicGroup(t),
+		withEpicIID(t),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		group, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		epicIID, err := RequiredInt(r, "epic_iid")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		children := epicChildren{}
+
+		// GitLab deprecated the epic REST APIs, epic links included, in favour of work items.
+		// They keep working until the REST API removes them; there is no REST replacement yet.
+		children.Epics, _, err = client.Epics.GetEpicLinks(group, epicIID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list child epics: %w", err).Error()), nil
+		}
+
+		children.Issues, _, err = client.EpicIssues.ListEpicIssues(group, epicIID, &pagination)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list epic issues: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(children)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// CreateEpic returns a tool for creating an epic
+func CreateEpic(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"create_epic",
+		mcp.WithDescription(t("TOOL_CREATE_EPIC_DESCRIPTION", "Create an epic in a group")),
+		withEpicGroup(t),
+		mcp.WithString("title",
+			mcp.Required(),
+			mcp.Description(t("PARAM_EPIC_TITLE_DESCRIPTION", "The title of the epic")),
+		),
+		mcp.WithString("description",
+			mcp.Description(t("PARAM_EPIC_DESCRIPTION_DESCRIPTION", "The description of the epic")),
+		),
+		mcp.WithArray("labels",
+			mcp.Description(t("PARAM_EPIC_LABELS_DESCRIPTION", "Labels of the epic")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		),
+		mcp.WithNumber("parent_id",
+			mcp.Description(t("PARAM_EPIC_PARENT_ID_DESCRIPTION", "The ID (not IID) of the parent epic")),
+		),
+		mcp.WithString("start_date",
+			mcp.Description(t("PARAM_EPIC_START_DATE_DESCRIPTION", "A fixed start date (YYYY-MM-DD)")),
+		),
+		mcp.WithString("due_date",
+			mcp.Description(t("PARAM_EPIC_DUE_DATE_DESCRIPTION", "A fixed due date (YYYY-MM-DD)")),
+		),
+		mcp.WithBoolean("confidential",
+			mcp.Description(t("PARAM_EPIC_CONFIDENTIAL_DESCRIPTION", "Whether the epic is confidential")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		group, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		title, err := requiredParam[string](r, "title")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		labels, err := optionalLabelOptions(r, "labels")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		parentID, err := OptionalInt(r, "parent_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		startDate, err := optionalISODate(r, "start_date")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		dueDate, err := optionalISODate(r, "due_date")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		description, err := OptionalParam[string](r, "description")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.CreateEpicOptions{
+			Title:  &title,
+			Labels: labels,
+		}
+		if description != "" {
+			opts.Description = &description
+		}
+		if parentID != 0 {
+			opts.ParentID = &parentID
+		}
+		if startDate != nil {
+			opts.StartDateIsFixed = gitlab.Ptr(true)
+			opts.StartDateFixed = startDate
+		}
+		if dueDate != nil {
+			opts.DueDateIsFixed = gitlab.Ptr(true)
+			opts.DueDateFixed = dueDate
+		}
+		if _, ok := r.Params.Arguments["confidential"]; ok {
+			confidential, err := OptionalParam[bool](r, "confidential")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Confidential = &confidential
+		}
+
+		epic, _, err := client.Epics.CreateEpic(group, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to create epic: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(epic)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// UpdateEpic returns a tool for updating an epic
+func UpdateEpic(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"update_epic",
+		mcp.WithDescription(t("TOOL_UPDATE_EPIC_DESCRIPTION", "Update an epic; fields that are not passed keep their current value")),
+		withEpicGroup(t),
+		withEpicIID(t),
+		mcp.WithString("title",
+			mcp.Description(t("PARAM_EPIC_TITLE_DESCRIPTION", "The title of the epic")),
+		),
+		mcp.WithString("description",
+			mcp.Description(t("PARAM_EPIC_DESCRIPTION_DESCRIPTION", "The description of the epic")),
+		),
+		mcp.WithString("state_event",
+			mcp.Description(t("PARAM_EPIC_STATE_EVENT_DESCRIPTION", "Close or reopen the epic")),
+			mcp.Enum("close", "reopen"),
+		),
+		mcp.WithArray("add_labels",
+			mcp.Description(t("PARAM_EPIC_ADD_LABELS_DESCRIPTION", "Labels to add to the epic")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		),
+		mcp.WithArray("remove_labels",
+			mcp.Description(t("PARAM_EPIC_REMOVE_LABELS_DESCRIPTION", "Labels to remove from the epic")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		),
+		mcp.WithNumber("parent_id",
+			mcp.Description(t("PARAM_EPIC_PARENT_ID_DESCRIPTION", "The ID (not IID) of the parent epic")),
+		),
+		mcp.WithString("start_date",
+			mcp.Description(t("PARAM_EPIC_START_DATE_DESCRIPTION", "A fixed start date (YYYY-MM-DD)")),
+		),
+		mcp.WithString("due_date",
+			mcp.Description(t("PARAM_EPIC_DUE_DATE_DESCRIPTION", "A fixed due date (YYYY-MM-DD)")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		group, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		epicIID, err := RequiredInt(r, "epic_iid")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		addLabels, err := optionalLabelOptions(r, "add_labels")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		removeLabels, err := optionalLabelOptions(r, "remove_labels")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		parentID, err := OptionalInt(r, "parent_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		startDate, err := optionalISODate(r, "start_date")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		dueDate, err := optionalISODate(r, "due_date")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		title, err := OptionalParam[string](r, "title")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		stateEvent, err := OptionalParam[string](r, "state_event")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.UpdateEpicOptions{
+			AddLabels:    addLabels,
+			RemoveLabels: removeLabels,
+		}
+		if title != "" {
+			opts.Title = &title
+		}
+		// an empty description clears it, so only a missing one keeps the current value
+		if _, ok := r.Params.Arguments["description"]; ok {
+			description, err := OptionalParam[string](r, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Description = &description
+		}
+		if stateEvent != "" {
+			opts.StateEvent = &stateEvent
+		}
+		if parentID != 0 {
+			opts.ParentID = &parentID
+		}
+		if startDate != nil {
+			opts.StartDateIsFixed = gitlab.Ptr(true)
+			opts.StartDateFixed = startDate
+		}
+		if dueDate != nil {
+			opts.DueDateIsFixed = gitlab.Ptr(true)
+			opts.DueDateFixed = dueDate
+		}
+
+		epic, _, err := client.Epics.UpdateEpic(group, epicIID, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to update epic: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(epic)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// AttachIssueToEpic returns a tool for adding an issue to an epic
+func AttachIssueToEpic(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"attach_issue_to_epic",
+		mcp.WithDescription(t("TOOL_ATTACH_ISSUE_TO_EPIC_DESCRIPTION", "Add an issue to an epic, moving it out of any epic it belonged to")),
+		withEpicGroup(t),
+		withEpicIID(t),
+		mcp.WithNumber("issue_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_EPIC_ISSUE_ID_DESCRIPTION", "The global ID (not IID) of the issue")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		group, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		epicIID, err := RequiredInt(r, "epic_iid")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		issueID, err := RequiredInt(r, "issue_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		assignment, _, err := client.EpicIssues.AssignEpicIssue(group, epicIID, issueID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to attach issue to epic: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(assignment)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// DetachIssueFromEpic returns a tool for removing an issue from an epic
+func DetachIssueFromEpic(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"detach_issue_from_epic",
+		mcp.WithDescription(t("TOOL_DETACH_ISSUE_FROM_EPIC_DESCRIPTION", "Remove an issue from an epic")),
+		withEpicGroup(t),
+		withEpicIID(t),
+		mcp.WithNumber("issue_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_EPIC_ISSUE_ID_DESCRIPTION", "The global ID (not IID) of the issue")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		group, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		epicIID, err := RequiredInt(r, "epic_iid")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		issueID, err := RequiredInt(r, "issue_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// Removing an issue needs the ID of its link to the epic, which is only known from the epic's issues
+		issues, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.Issue, *gitlab.Response, error) {
+			return client.EpicIssues.ListEpicIssues(group, epicIID, &opts)
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list epic issues: %w", err).Error()), nil
+		}
+		epicIssueID := 0
+		for _, issue := range issues {
+			if issue.ID == issueID {
+				epicIssueID = issue.EpicIssueID
+				break
+			}
+		}
+		if epicIssueID == 0 {
+			if morePages(resp) {
+				return mcp.NewToolResultError(fmt.Sprintf("issue %d is not among the first %d issues of epic &%d", issueID, len(issues), epicIID)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("issue %d is not part of epic &%d", issueID, epicIID)), nil
+		}
+
+		_, _, err = client.EpicIssues.RemoveEpicIssue(group, epicIID, epicIssueID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to detach issue from epic: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Issue %d removed from epic &%d", issueID, epicIID)), nil
+	}
+
+	return tool, handler
+}
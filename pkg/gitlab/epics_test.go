@@ -0,0 +1,609 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockEpicsService is a mock implementation of the GitLab epics service
+type mockEpicsService struct {
+	listFunc   func(gid interface{}, opt *gitlab.ListGroupEpicsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Epic, *gitlab.Response, error)
+	getFunc    func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error)
+	linksFunc  func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) ([]*gitlab.Epic, *gitlab.Response, error)
+	createFunc func(gid interface{}, opt *gitlab.CreateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error)
+	updateFunc func(gid interface{}, epic int, opt *gitlab.UpdateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error)
+}
+
+// ensure mockEpicsService implements the gitlab.EpicsServiceInterface
+var _ gitlab.EpicsServiceInterface = &mockEpicsService{}
+
+func (m *mockEpicsService) ListGroupEpics(gid interface{}, opt *gitlab.ListGroupEpicsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Epic, *gitlab.Response, error) {
+	return m.listFunc(gid, opt, options...)
+}
+
+func (m *mockEpicsService) GetEpic(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+	return m.getFunc(gid, epic, options...)
+}
+
+func (m *mockEpicsService) GetEpicLinks(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) ([]*gitlab.Epic, *gitlab.Response, error) {
+	return m.linksFunc(gid, epic, options...)
+}
+
+func (m *mockEpicsService) CreateEpic(gid interface{}, opt *gitlab.CreateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+	return m.createFunc(gid, opt, options...)
+}
+
+func (m *mockEpicsService) UpdateEpic(gid interface{}, epic int, opt *gitlab.UpdateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+	return m.updateFunc(gid, epic, opt, options...)
+}
+
+func (m *mockEpicsService) DeleteEpic(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+// mockEpicIssuesService is a mock implementation of the GitLab epic issues service
+type mockEpicIssuesService struct {
+	listFunc   func(gid interface{}, epic int, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error)
+	removeFunc func(gid interface{}, epic, epicIssue int, options ...gitlab.RequestOptionFunc) (*gitlab.EpicIssueAssignment, *gitlab.Response, error)
+	assignFunc func(gid interface{}, epic, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.EpicIssueAssignment, *gitlab.Response, error)
+}
+
+// ensure mockEpicIssuesService implements the gitlab.EpicIssuesServiceInterface
+var _ gitlab.EpicIssuesServiceInterface = &mockEpicIssuesService{}
+
+func (m *mockEpicIssuesService) ListEpicIssues(gid interface{}, epic int, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+	return m.listFunc(gid, epic, opt, options...)
+}
+
+func (m *mockEpicIssuesService) RemoveEpicIssue(gid interface{}, epic, epicIssue int, options ...gitlab.RequestOptionFunc) (*gitlab.EpicIssueAssignment, *gitlab.Response, error) {
+	return m.removeFunc(gid, epic, epicIssue, options...)
+}
+
+func (m *mockEpicIssuesService) AssignEpicIssue(gid interface{}, epic, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.EpicIssueAssignment, *gitlab.Response, error) {
+	return m.assignFunc(gid, epic, issue, options...)
+}
+
+func (m *mockEpicIssuesService) UpdateEpicIssueAssignment(gid interface{}, epic, epicIssue int, opt *gitlab.UpdateEpicIsssueAssignmentOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestDetachIssueFromEpic(t *testing.T) {
+	tests := []struct {
+		name                string
+		args                map[string]interface{}
+		expectedEpicIssueID int
+		expectedText        string
+		expectedError       string
+	}{
+		{
+			name: "removes the epic issue link",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+				"epic_iid":  float64(5),
+				"issue_id":  float64(102),
+			},
+			expectedEpicIssueID: 12,
+			expectedText:        "Issue 102 removed from epic &5",
+		},
+		{
+			name: "issue not in epic",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+				"epic_iid":  float64(5),
+				"issue_id":  float64(999),
+			},
+			expectedError: "issue 999 is not part of epic &5",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			removedID := 0
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					EpicIssues: &mockEpicIssuesService{
+						listFunc: func(gid interface{}, epic int, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+							assert.Equal(t, "test-group", gid)
+							assert.Equal(t, 5, epic)
+							return []*gitlab.Issue{
+								{ID: 101, EpicIssueID: 11},
+								{ID: 102, EpicIssueID: 12},
+							}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+						removeFunc: func(gid interface{}, epic, epicIssue int, options ...gitlab.RequestOptionFunc) (*gitlab.EpicIssueAssignment, *gitlab.Response, error) {
+							removedID = epicIssue
+							return &gitlab.EpicIssueAssignment{}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := DetachIssueFromEpic(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				assert.Zero(t, removedID)
+				return
+			}
+
+			assert.Equal(t, tc.expectedText, textContent.Text)
+			assert.Equal(t, tc.expectedEpicIssueID, removedID)
+		})
+	}
+}
+
+func TestListEpics(t *testing.T) {
+	tests := []struct {
+		name                       string
+		args                       map[string]interface{}
+		expectedState              *string
+		expectedLabels             *gitlab.LabelOptions
+		expectedIncludeDescendants *bool
+		expectedError              string
+	}{
+		{
+			name: "all epics",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+			},
+		},
+		{
+			name: "open epics with labels outside subgroups",
+			args: map[string]interface{}{
+				"namespace":                 "test-group",
+				"state":                     "opened",
+				"labels":                    []interface{}{"roadmap"},
+				"include_descendant_groups": false,
+			},
+			expectedState:              gitlab.Ptr("opened"),
+			expectedLabels:             &gitlab.LabelOptions{"roadmap"},
+			expectedIncludeDescendants: gitlab.Ptr(false),
+		},
+		{
+			name: "include_descendant_groups of the wrong type",
+			args: map[string]interface{}{
+				"namespace":                 "test-group",
+				"include_descendant_groups": "no",
+			},
+			expectedError: "parameter include_descendant_groups is not of type bool",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Epics: &mockEpicsService{
+						listFunc: func(gid interface{}, opt *gitlab.ListGroupEpicsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Epic, *gitlab.Response, error) {
+							assert.Equal(t, "test-group", gid)
+							assert.Equal(t, tc.expectedState, opt.State)
+							assert.Equal(t, tc.expectedLabels, opt.Labels)
+							assert.Equal(t, tc.expectedIncludeDescendants, opt.IncludeDescendantGroups)
+							return []*gitlab.Epic{{IID: 5, Title: "Roadmap"}}, nil, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListEpics(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var epics []*gitlab.Epic
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &epics))
+			require.Len(t, epics, 1)
+			assert.Equal(t, "Roadmap", epics[0].Title)
+		})
+	}
+}
+
+func TestGetEpic(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		getError      error
+		expectedError string
+	}{
+		{
+			name: "epic found",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+				"epic_iid":  float64(5),
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+				"epic_iid":  float64(5),
+			},
+			getError:      fmt.Errorf("404 Not Found"),
+			expectedError: "failed to get epic: 404 Not Found",
+		},
+		{
+			name: "missing epic IID",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+			},
+			expectedError: "missing required parameter: epic_iid",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Epics: &mockEpicsService{
+						getFunc: func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+							assert.Equal(t, "test-group", gid)
+							if tc.getError != nil {
+								return nil, nil, tc.getError
+							}
+							return &gitlab.Epic{ID: 50, IID: epic, Title: "Roadmap"}, nil, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := GetEpic(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var epic gitlab.Epic
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &epic))
+			assert.Equal(t, 5, epic.IID)
+			assert.Equal(t, "Roadmap", epic.Title)
+		})
+	}
+}
+
+func TestListEpicChildren(t *testing.T) {
+	tests := []struct {
+		name          string
+		linksError    error
+		expectedError string
+	}{
+		{
+			name: "child epics and issues",
+		},
+		{
+			name:          "child epics unavailable",
+			linksError:    fmt.Errorf("403 Forbidden"),
+			expectedError: "failed to list child epics: 403 Forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Epics: &mockEpicsService{
+						linksFunc: func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) ([]*gitlab.Epic, *gitlab.Response, error) {
+							assert.Equal(t, "test-group", gid)
+							assert.Equal(t, 5, epic)
+							if tc.linksError != nil {
+								return nil, nil, tc.linksError
+							}
+							return []*gitlab.Epic{{IID: 6, Title: "Backend"}}, nil, nil
+						},
+					},
+					EpicIssues: &mockEpicIssuesService{
+						listFunc: func(gid interface{}, epic int, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+							assert.Equal(t, 2, opt.Page)
+							return []*gitlab.Issue{{ID: 101, Title: "Add endpoint"}}, nil, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListEpicChildren(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"namespace": "test-group",
+				"epic_iid":  float64(5),
+				"page":      float64(2),
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var children epicChildren
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &children))
+			require.Len(t, children.Epics, 1)
+			assert.Equal(t, "Backend", children.Epics[0].Title)
+			require.Len(t, children.Issues, 1)
+			assert.Equal(t, "Add endpoint", children.Issues[0].Title)
+		})
+	}
+}
+
+func TestCreateEpic(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "epic with fixed dates",
+			args: map[string]interface{}{
+				"namespace":    "test-group",
+				"title":        "Roadmap",
+				"description":  "Next quarter",
+				"labels":       []interface{}{"roadmap"},
+				"parent_id":    float64(40),
+				"start_date":   "2025-04-01",
+				"due_date":     "2025-06-30",
+				"confidential": true,
+			},
+		},
+		{
+			name: "invalid due date",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+				"title":     "Roadmap",
+				"due_date":  "end of June",
+			},
+			expectedError: "due_date",
+		},
+		{
+			name: "missing title",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+			},
+			expectedError: "missing required parameter: title",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Epics: &mockEpicsService{
+						createFunc: func(gid interface{}, opt *gitlab.CreateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+							assert.Equal(t, "test-group", gid)
+							assert.Equal(t, "Next quarter", *opt.Description)
+							assert.Equal(t, &gitlab.LabelOptions{"roadmap"}, opt.Labels)
+							assert.Equal(t, 40, *opt.ParentID)
+							assert.True(t, *opt.StartDateIsFixed)
+							assert.Equal(t, "2025-04-01", opt.StartDateFixed.String())
+							assert.True(t, *opt.DueDateIsFixed)
+							assert.Equal(t, "2025-06-30", opt.DueDateFixed.String())
+							assert.True(t, *opt.Confidential)
+							return &gitlab.Epic{IID: 7, Title: *opt.Title}, nil, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := CreateEpic(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var epic gitlab.Epic
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &epic))
+			assert.Equal(t, 7, epic.IID)
+			assert.Equal(t, "Roadmap", epic.Title)
+		})
+	}
+}
+
+func TestUpdateEpic(t *testing.T) {
+	tests := []struct {
+		name                string
+		args                map[string]interface{}
+		expectedTitle       *string
+		expectedDescription *string
+		expectedStateEvent  *string
+		expectedAddLabels   *gitlab.LabelOptions
+		expectedError       string
+	}{
+		{
+			name: "close with labels",
+			args: map[string]interface{}{
+				"namespace":   "test-group",
+				"epic_iid":    float64(5),
+				"state_event": "close",
+				"add_labels":  []interface{}{"done"},
+			},
+			expectedStateEvent: gitlab.Ptr("close"),
+			expectedAddLabels:  &gitlab.LabelOptions{"done"},
+		},
+		{
+			name: "empty description clears it",
+			args: map[string]interface{}{
+				"namespace":   "test-group",
+				"epic_iid":    float64(5),
+				"title":       "Renamed",
+				"description": "",
+			},
+			expectedTitle:       gitlab.Ptr("Renamed"),
+			expectedDescription: gitlab.Ptr(""),
+		},
+		{
+			name: "description of the wrong type",
+			args: map[string]interface{}{
+				"namespace":   "test-group",
+				"epic_iid":    float64(5),
+				"description": float64(1),
+			},
+			expectedError: "parameter description is not of type string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Epics: &mockEpicsService{
+						updateFunc: func(gid interface{}, epic int, opt *gitlab.UpdateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+							assert.Equal(t, "test-group", gid)
+							assert.Equal(t, 5, epic)
+							assert.Equal(t, tc.expectedTitle, opt.Title)
+							assert.Equal(t, tc.expectedDescription, opt.Description)
+							assert.Equal(t, tc.expectedStateEvent, opt.StateEvent)
+							assert.Equal(t, tc.expectedAddLabels, opt.AddLabels)
+							return &gitlab.Epic{IID: epic}, nil, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := UpdateEpic(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var epic gitlab.Epic
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &epic))
+			assert.Equal(t, 5, epic.IID)
+		})
+	}
+}
+
+func TestAttachIssueToEpic(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		assignError   error
+		expectedError string
+	}{
+		{
+			name: "issue attached",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+				"epic_iid":  float64(5),
+				"issue_id":  float64(102),
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+				"epic_iid":  float64(5),
+				"issue_id":  float64(102),
+			},
+			assignError:   fmt.Errorf("404 Not Found"),
+			expectedError: "failed to attach issue to epic: 404 Not Found",
+		},
+		{
+			name: "missing issue ID",
+			args: map[string]interface{}{
+				"namespace": "test-group",
+				"epic_iid":  float64(5),
+			},
+			expectedError: "missing required parameter: issue_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					EpicIssues: &mockEpicIssuesService{
+						assignFunc: func(gid interface{}, epic, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.EpicIssueAssignment, *gitlab.Response, error) {
+							assert.Equal(t, "test-group", gid)
+							assert.Equal(t, 5, epic)
+							if tc.assignError != nil {
+								return nil, nil, tc.assignError
+							}
+							return &gitlab.EpicIssueAssignment{ID: 12, Epic: &gitlab.Epic{IID: epic}, Issue: &gitlab.Issue{ID: issue}}, nil, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := AttachIssueToEpic(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var assignment gitlab.EpicIssueAssignment
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &assignment))
+			assert.Equal(t, 12, assignment.ID)
+			assert.Equal(t, 102, assignment.Issue.ID)
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// eventPush is the push data of an event
+type eventPush struct {
+	CommitCount int
+	Action      string
+	RefType     string
+	Ref         string
+	CommitTitle string
+}
+
+// eventSummary is an event with a one-line description of what happened
+type eventSummary struct {
+	ID          int    `json:"id"`
+	CreatedAt   string `json:"created_at"`
+	Author      string `json:"author"`
+	ProjectID   int    `json:"project_id,omitempty"`
+	Action      string `json:"action"`
+	TargetType  string `json:"target_type,omitempty"`
+	TargetIID   int    `json:"target_iid,omitempty"`
+	TargetTitle string `json:"target_title,omitempty"`
+	Summary     string `json:"summary"`
+
+	push         eventPush
+	noteableType string
+	noteableIID  int
+}
+
+// summarizeContributionEvent converts a user contribution event
+func summarizeContributionEvent(event *gitlab.ContributionEvent) eventSummary {
+	summary := eventSummary{
+		ID:          event.ID,
+		Author:      event.AuthorUsername,
+		ProjectID:   event.ProjectID,
+		Action:      event.ActionName,
+		TargetType:  event.TargetType,
+		TargetIID:   event.TargetIID,
+		TargetTitle: event.TargetTitle,
+		push: eventPush{
+			CommitCount: event.PushData.CommitCount,
+			Action:      event.PushData.Action,
+			RefType:     event.PushData.RefType,
+			Ref:         event.PushData.Ref,
+			CommitTitle: event.PushData.CommitTitle,
+		},
+	}
+	if event.CreatedAt != nil {
+		summary.CreatedAt = event.CreatedAt.Format(time.RFC3339)
+	}
+	if event.Note != nil {
+		summary.noteableType = event.Note.NoteableType
+		summary.noteableIID = event.Note.NoteableIID
+	}
+	summary.Summary = describeEvent(summary)
+	return summary
+}
+
+// summarizeProjectEvent converts a project event
+func summarizeProjectEvent(event *gitlab.ProjectEvent) eventSummary {
+	summary := eventSummary{
+		ID:          event.ID,
+		CreatedAt:   event.CreatedAt,
+		Author:      event.AuthorUsername,
+		ProjectID:   event.ProjectID,
+		Action:      event.ActionName,
+		TargetType:  event.TargetType,
+		TargetIID:   event.TargetIID,
+		TargetTitle: event.TargetTitle,
+		push: eventPush{
+			CommitCount: event.PushData.CommitCount,
+			Action:      event.PushData.Action,
+			RefType:     event.PushData.RefType,
+			Ref:         event.PushData.Ref,
+			CommitTitle: event.PushData.CommitTitle,
+		},
+		noteableType: event.Note.NoteableType,
+		noteableIID:  event.Note.NoteableIID,
+	}
+	summary.Summary = describeEvent(summary)
+	return summary
+}
+
+// eventReference renders the reference of an event target, such as #12 for an issue or !42 for a merge request
+func eventReference(targetType string, iid int, title string) string {
+	var reference string
+	switch targetType {
+	case "Issue", "WorkItem":
+		reference = fmt.Sprintf("#%d", iid)
+	case "MergeRequest":
+		reference = fmt.Sprintf("!%d", iid)
+	case "Milestone":
+		reference = "milestone"
+	case "":
+		return ""
+	default:
+		reference = strings.ToLower(strings.TrimSuffix(targetType, "::Meta"))
+		if iid != 0 {
+			reference = fmt.Sprintf("%s %d", reference, iid)
+		}
+	}
+	if title != "" {
+		reference = fmt.Sprintf("%s %q", reference, title)
+	}
+	return reference
+}
+
+// describeEvent writes a one-line summary of an event, such as "pushed 3 commits to main" or "approved !42"
+func describeEvent(event eventSummary) string {
+	if strings.HasPrefix(event.Action, "pushed") || (event.Action == "deleted" && event.push.Ref != "") {
+		push := event.push
+		switch {
+		case push.Action == "created":
+			return fmt.Sprintf("created %s %s", push.RefType, push.Ref)
+		case push.Action == "removed" || event.Action == "deleted":
+			return fmt.Sprintf("deleted %s %s", push.RefType, push.Ref)
+		case push.CommitCount == 1 && push.CommitTitle != "":
+			return fmt.Sprintf("pushed 1 commit to %s: %s", push.Ref, push.CommitTitle)
+		case push.CommitCount == 1:
+			return fmt.Sprintf("pushed 1 commit to %s", push.Ref)
+		default:
+			return fmt.Sprintf("pushed %d commits to %s", push.CommitCount, push.Ref)
+		}
+	}
+
+	action := event.Action
+	if action == "accepted" {
+		// GitLab names merging a merge request "accepted"
+		action = "merged"
+	}
+
+	if event.noteableType != "" {
+		// comment events target the note, so describe the commented issue or merge request instead
+		return fmt.Sprintf("%s %s", action, eventReference(event.noteableType, event.noteableIID, event.TargetTitle))
+	}
+
+	if reference := eventReference(event.TargetType, event.TargetIID, event.TargetTitle); reference != "" {
+		return fmt.Sprintf("%s %s", action, reference)
+	}
+	return fmt.Sprintf("%s the project", action)
+}
+
+// withEventFilters adds the filter and pagination parameters shared by the event tools
+func withEventFilters(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("action",
+			mcp.Description(t("PARAM_EVENT_ACTION_DESCRIPTION", "Only return events of this action")),
+			mcp.Enum("created", "updated", "closed", "reopened", "pushed", "commented", "merged", "joined", "left", "destroyed", "expired", "approved"),
+		)(tool)
+		mcp.WithString("target_type",
+			mcp.Description(t("PARAM_EVENT_TARGET_TYPE_DESCRIPTION", "Only return events on this type of target")),
+			mcp.Enum("issue", "milestone", "merge_request", "note", "project", "snippet", "user"),
+		)(tool)
+		mcp.WithString("after",
+			mcp.Description(t("PARAM_EVENT_AFTER_DESCRIPTION", "Only return events created after this date (YYYY-MM-DD)")),
+		)(tool)
+		mcp.WithString("before",
+			mcp.Description(t("PARAM_EVENT_BEFORE_DESCRIPTION", "Only return events created before this date (YYYY-MM-DD)")),
+		)(tool)
+		mcp.WithString("sort",
+			mcp.Description(t("PARAM_EVENT_SORT_DESCRIPTION", "Sort events by creation date. Defaults to desc")),
+			mcp.Enum("asc", "desc"),
+		)(tool)
+		withPagination(t)(tool)
+	}
+}
+
+// eventFilters reads the parameters added by withEventFilters
+func eventFilters(r mcp.CallToolRequest) (*gitlab.ListContributionEventsOptions, error) {
+	action, err := OptionalParam[string](r, "action")
+	if err != nil {
+		return nil, err
+	}
+	targetType, err := OptionalParam[string](r, "target_type")
+	if err != nil {
+		return nil, err
+	}
+	after, err := optionalISODate(r, "after")
+	if err != nil {
+		return nil, err
+	}
+	before, err := optionalISODate(r, "before")
+	if err != nil {
+		return nil, err
+	}
+	sort, err := OptionalParam[string](r, "sort")
+	if err != nil {
+		return nil, err
+	}
+	pagination, err := OptionalPaginationParams(r)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &gitlab.ListContributionEventsOptions{
+		ListOptions: pagination,
+		After:       after,
+		Before:      before,
+	}
+	if action != "" {
+		opts.Action = gitlab.Ptr(gitlab.EventTypeValue(action))
+	}
+	if targetType != "" {
+		opts.TargetType = gitlab.Ptr(gitlab.EventTargetTypeValue(targetType))
+	}
+	if sort != "" {
+		opts.Sort = gitlab.Ptr(sort)
+	}
+	return opts, nil
+}
+
+// eventsResult marshals event summaries as the tool response
+func eventsResult(summaries []eventSummary) (*mcp.CallToolResult, error) {
+	jsonData, err := json.Marshal(summaries)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// ListUserEvents returns a tool for listing the contribution events of a user
+func ListUserEvents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_user_events",
+		mcp.WithDescription(t("TOOL_LIST_USER_EVENTS_DESCRIPTION", "List the contribution events of a user, each with a one-line summary of what happened")),
+		mcp.WithString("username",
+			mcp.Required(),
+			mcp.Description(t("PARAM_USERNAME_DESCRIPTION", "The username of the user")),
+		),
+		withEventFilters(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		username, err := requiredParam[string](r, "username")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opts, err := eventFilters(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		events, _, err := client.Users.ListUserContributionEvents(username, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list user events: %w", err).Error()), nil
+		}
+
+		summaries := make([]eventSummary, 0, len(events))
+		for _, event := range events {
+			summaries = append(summaries, summarizeContributionEvent(event))
+		}
+		return eventsResult(summaries)
+	}
+
+	return tool, handler
+}
+
+// ListProjectEvents returns a tool for listing the events of a project
+func ListProjectEvents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_project_events",
+		mcp.WithDescription(t("TOOL_LIST_PROJECT_EVENTS_DESCRIPTION", "List the events of a project, each with a one-line summary of what happened")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		withEventFilters(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opts, err := eventFilters(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		events, _, err := client.Events.ListProjectVisibleEvents(fmt.Sprintf("%s/%s", namespace, project), (*gitlab.ListProjectVisibleEventsOptions)(opts))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list project events: %w", err).Error()), nil
+		}
+
+		summaries := make([]eventSummary, 0, len(events))
+		for _, event := range events {
+			summaries = append(summaries, summarizeProjectEvent(event))
+		}
+		return eventsResult(summaries)
+	}
+
+	return tool, handler
+}
+
+// ListMyContributionEvents returns a tool for listing the contribution events of the authenticated user
+func ListMyContributionEvents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_my_contribution_events",
+		mcp.WithDescription(t("TOOL_LIST_MY_CONTRIBUTION_EVENTS_DESCRIPTION", "List the contribution events of the authenticated user, each with a one-line summary of what happened")),
+		withEventFilters(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		opts, err := eventFilters(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		events, _, err := client.Events.ListCurrentUserContributionEvents(opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list contribution events: %w", err).Error()), nil
+		}
+
+		summaries := make([]eventSummary, 0, len(events))
+		for _, event := range events {
+			summaries = append(summaries, summarizeContributionEvent(event))
+		}
+		return eventsResult(summaries)
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,166 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockEventsService is a mock implementation of the GitLab events service
+type mockEventsService struct {
+	listContributionFunc func(opt *gitlab.ListContributionEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ContributionEvent, *gitlab.Response, error)
+}
+
+// ensure mockEventsService implements the gitlab.EventsServiceInterface
+var _ gitlab.EventsServiceInterface = &mockEventsService{}
+
+func (m *mockEventsService) ListCurrentUserContributionEvents(opt *gitlab.ListContributionEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ContributionEvent, *gitlab.Response, error) {
+	return m.listContributionFunc(opt, options...)
+}
+
+func (m *mockEventsService) ListProjectVisibleEvents(pid interface{}, opt *gitlab.ListProjectVisibleEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestDescribeEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    eventSummary
+		expected string
+	}{
+		{
+			name:     "push of several commits",
+			event:    eventSummary{Action: "pushed to", push: eventPush{CommitCount: 3, Action: "pushed", RefType: "branch", Ref: "main"}},
+			expected: "pushed 3 commits to main",
+		},
+		{
+			name:     "push of one commit",
+			event:    eventSummary{Action: "pushed to", push: eventPush{CommitCount: 1, Action: "pushed", RefType: "branch", Ref: "main", CommitTitle: "Fix typo"}},
+			expected: "pushed 1 commit to main: Fix typo",
+		},
+		{
+			name:     "new branch",
+			event:    eventSummary{Action: "pushed new", push: eventPush{Action: "created", RefType: "branch", Ref: "feature"}},
+			expected: "created branch feature",
+		},
+		{
+			name:     "deleted tag",
+			event:    eventSummary{Action: "deleted", push: eventPush{Action: "removed", RefType: "tag", Ref: "v1.0"}},
+			expected: "deleted tag v1.0",
+		},
+		{
+			name:     "approved merge request",
+			event:    eventSummary{Action: "approved", TargetType: "MergeRequest", TargetIID: 42, TargetTitle: "Add caching"},
+			expected: `approved !42 "Add caching"`,
+		},
+		{
+			name:     "merged merge request",
+			event:    eventSummary{Action: "accepted", TargetType: "MergeRequest", TargetIID: 42},
+			expected: "merged !42",
+		},
+		{
+			name:     "comment on issue",
+			event:    eventSummary{Action: "commented on", TargetType: "DiffNote", TargetTitle: "Login fails", noteableType: "Issue", noteableIID: 7},
+			expected: `commented on #7 "Login fails"`,
+		},
+		{
+			name:     "milestone",
+			event:    eventSummary{Action: "created", TargetType: "Milestone", TargetTitle: "v2.0"},
+			expected: `created milestone "v2.0"`,
+		},
+		{
+			name:     "joined project",
+			event:    eventSummary{Action: "joined"},
+			expected: "joined the project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, describeEvent(tc.event))
+		})
+	}
+}
+
+func TestListMyContributionEvents(t *testing.T) {
+	createdAt := time.Date(2024, 5, 6, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mockResponse  []*gitlab.ContributionEvent
+		mockError     error
+		expectedAfter *gitlab.ISOTime
+		expected      []eventSummary
+		expectedError string
+	}{
+		{
+			name: "events summarized",
+			args: map[string]interface{}{
+				"after": "2024-05-01",
+			},
+			mockResponse: []*gitlab.ContributionEvent{
+				{ID: 1, ProjectID: 3, ActionName: "approved", TargetType: "MergeRequest", TargetIID: 42, TargetTitle: "Add caching", AuthorUsername: "alice", CreatedAt: &createdAt},
+			},
+			expectedAfter: gitlab.Ptr(gitlab.ISOTime(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC))),
+			expected: []eventSummary{
+				{ID: 1, CreatedAt: "2024-05-06T10:00:00Z", Author: "alice", ProjectID: 3, Action: "approved", TargetType: "MergeRequest", TargetIID: 42, TargetTitle: "Add caching", Summary: `approved !42 "Add caching"`},
+			},
+		},
+		{
+			name: "invalid date",
+			args: map[string]interface{}{
+				"before": "last week",
+			},
+			expectedError: "parameter before is not a valid date or RFC 3339 timestamp: last week",
+		},
+		{
+			name:          "GitLab API error",
+			args:          map[string]interface{}{},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to list contribution events: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Events: &mockEventsService{
+						listContributionFunc: func(opt *gitlab.ListContributionEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ContributionEvent, *gitlab.Response, error) {
+							assert.Equal(t, tc.expectedAfter, opt.After)
+							return tc.mockResponse, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListMyContributionEvents(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got []eventSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// historyEntry is one change in the timeline of an issue or merge request
+type historyEntry struct {
+	At     *time.Time `json:"at"`
+	Actor  string     `json:"actor"`
+	Kind   string     `json:"kind"`
+	Change string     `json:"change"`
+}
+
+// history is the response of the history tools
+type history struct {
+	Timeline []historyEntry `json:"timeline"`
+	// Unavailable lists the kinds of events the instance does not provide, such as weight events without a license
+	Unavailable []string `json:"unavailable,omitempty"`
+	// Truncated lists the kinds of events with more than maxListPages pages, of which only the oldest were read
+	Truncated []string `json:"truncated,omitempty"`
+}
+
+// historyUnavailable reports whether a resource event endpoint is missing or not licensed on the instance
+func historyUnavailable(resp *gitlab.Response) bool {
+	return resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden)
+}
+
+// userName returns the username of an optional user
+func userName(user *gitlab.BasicUser) string {
+	if user == nil {
+		return ""
+	}
+	return user.Username
+}
+
+// buildHistory merges resource events into one chronological timeline.
+// Label events by the same user at the same time, such as swapping a scoped label, become a single entry.
+func buildHistory(labels []*gitlab.LabelEvent, states []*gitlab.StateEvent, milestones []*gitlab.MilestoneEvent, weights []*gitlab.WeightEvent, iterations []*gitlab.IterationEvent) []historyEntry {
+	timeline := []historyEntry{}
+
+	type labelChange struct {
+		at      *time.Time
+		actor   string
+		added   []string
+		removed []string
+	}
+	var labelChanges []*labelChange
+	for _, event := range labels {
+		var change *labelChange
+		if n := len(labelChanges); n > 0 {
+			last := labelChanges[n-1]
+			if last.actor == event.User.Username && last.at != nil && event.CreatedAt != nil && last.at.Equal(*event.CreatedAt) {
+				change = last
+			}
+		}
+		if change == nil {
+			change = &labelChange{at: event.CreatedAt, actor: event.User.Username}
+			labelChanges = append(labelChanges, change)
+		}
+		label := fmt.Sprintf("~%q", event.Label.Name)
+		if event.Action == "remove" {
+			change.removed = append(change.removed, label)
+		} else {
+			change.added = append(change.added, label)
+		}
+	}
+	for _, change := range labelChanges {
+		var parts []string
+		if len(change.added) > 0 {
+			parts = append(parts, "added "+strings.Join(change.added, " "))
+		}
+		if len(change.removed) > 0 {
+			parts = append(parts, "removed "+strings.Join(change.removed, " "))
+		}
+		timeline = append(timeline, historyEntry{At: change.at, Actor: change.actor, Kind: "label", Change: strings.Join(parts, ", ")})
+	}
+
+	for _, event := range states {
+		timeline = append(timeline, historyEntry{At: event.CreatedAt, Actor: userName(event.User), Kind: "state", Change: string(event.State)})
+	}
+
+	for _, event := range milestones {
+		title := ""
+		if event.Milestone != nil {
+			title = fmt.Sprintf(" %%%q", event.Milestone.Title)
+		}
+		change := "set milestone" + title
+		if event.Action == "remove" {
+			change = "removed milestone" + title
+		}
+		timeline = append(timeline, historyEntry{At: event.CreatedAt, Actor: userName(event.User), Kind: "milestone", Change: change})
+	}
+
+	for _, event := range weights {
+		timeline = append(timeline, historyEntry{At: event.CreatedAt, Actor: userName(event.User), Kind: "weight", Change: fmt.Sprintf("set weight to %d", event.Weight)})
+	}
+
+	for _, event := range iterations {
+		title := ""
+		if event.Iteration != nil {
+			title = fmt.Sprintf(" %q", event.Iteration.Title)
+		}
+		change := "set iteration" + title
+		if event.Action == "remove" {
+			change = "removed iteration" + title
+		}
+		timeline = append(timeline, historyEntry{At: event.CreatedAt, Actor: userName(event.User), Kind: "iteration", Change: change})
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		a, b := timeline[i].At, timeline[j].At
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return a.Before(*b)
+	})
+
+	return timeline
+}
+
+// withHistoryTarget adds the parameters identifying the issue or merge request of a history tool
+func withHistoryTarget(t translations.TranslationHelperFunc, idDescription string) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		)(tool)
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		)(tool)
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description(idDescription),
+		)(tool)
+	}
+}
+
+// historyResult marshals a history as the tool response
+func historyResult(h history) (*mcp.CallToolResult, error) {
+	jsonData, err := json.Marshal(h)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// GetIssueHistory returns a tool for getting the timeline of label, state, milestone, weight and iteration changes of an issue
+func GetIssueHistory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_issue_history",
+		mcp.WithDescription(t("TOOL_GET_ISSUE_HISTORY_DESCRIPTION", "Get the chronological timeline of label, state, milestone, weight and iteration changes of an issue, with who made each change")),
+		withHistoryTarget(t, t("PARAM_ISSUE_ID_DESCRIPTION", "The ID of the issue")),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := RequiredInt(r, "id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pid := fmt.Sprintf("%s/%s", namespace, project)
+
+		var truncated []string
+		labels, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.LabelEvent, *gitlab.Response, error) {
+			return client.ResourceLabelEvents.ListIssueLabelEvents(pid, id, &gitlab.ListLabelEventsOptions{ListOptions: opts})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list label events: %w", err).Error()), nil
+		}
+		if morePages(resp) {
+			truncated = append(truncated, "label")
+		}
+		states, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.StateEvent, *gitlab.Response, error) {
+			return client.ResourceStateEvents.ListIssueStateEvents(pid, id, &gitlab.ListStateEventsOptions{ListOptions: opts})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list state events: %w", err).Error()), nil
+		}
+		if morePages(resp) {
+			truncated = append(truncated, "state")
+		}
+		milestones, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.MilestoneEvent, *gitlab.Response, error) {
+			return client.ResourceMilestoneEvents.ListIssueMilestoneEvents(pid, id, &gitlab.ListMilestoneEventsOptions{ListOptions: opts})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list milestone events: %w", err).Error()), nil
+		}
+		if morePages(resp) {
+			truncated = append(truncated, "milestone")
+		}
+
+		var unavailable []string
+		// weights and iterations are paid features, so their absence is reported instead of failing
+		weights, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.WeightEvent, *gitlab.Response, error) {
+			return client.ResourceWeightEvents.ListIssueWeightEvents(pid, id, &gitlab.ListWeightEventsOptions{ListOptions: opts})
+		})
+		if err != nil {
+			if !historyUnavailable(resp) {
+				return mcp.NewToolResultError(fmt.Errorf("failed to list weight events: %w", err).Error()), nil
+			}
+			unavailable = append(unavailable, "weight")
+		} else if morePages(resp) {
+			truncated = append(truncated, "weight")
+		}
+		iterations, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.IterationEvent, *gitlab.Response, error) {
+			return client.ResourceIterationEvents.ListIssueIterationEvents(pid, id, &gitlab.ListIterationEventsOptions{ListOptions: opts})
+		})
+		if err != nil {
+			if !historyUnavailable(resp) {
+				return mcp.NewToolResultError(fmt.Errorf("failed to list iteration events: %w", err).Error()), nil
+			}
+			unavailable = append(unavailable, "iteration")
+		} else if morePages(resp) {
+			truncated = append(truncated, "iteration")
+		}
+
+		return historyResult(history{
+			Timeline:    buildHistory(labels, states, milestones, weights, iterations),
+			Unavailable: unavailable,
+			Truncated:   truncated,
+		})
+	}
+
+	return tool, handler
+}
+
+// GetMergeRequestHistory returns a tool for getting the timeline of label, state and milestone changes of a merge request
+func GetMergeRequestHistory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_merge_request_history",
+		mcp.WithDescription(t("TOOL_GET_MERGE_REQUEST_HISTORY_DESCRIPTION", "Get the chronological timeline of label, state and milestone changes of a merge request, with who made each change")),
+		withHistoryTarget(t, t("PARAM_MERGE_REQUEST_ID_DESCRIPTION", "The ID of the merge request")),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := RequiredInt(r, "id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pid := fmt.Sprintf("%s/%s", namespace, project)
+
+		var truncated []string
+		labels, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.LabelEvent, *gitlab.Response, error) {
+			return client.ResourceLabelEvents.ListMergeRequestsLabelEvents(pid, id, &gitlab.ListLabelEventsOptions{ListOptions: opts})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list label events: %w", err).Error()), nil
+		}
+		if morePages(resp) {
+			truncated = append(truncated, "label")
+		}
+		states, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.StateEvent, *gitlab.Response, error) {
+			return client.ResourceStateEvents.ListMergeStateEvents(pid, id, &gitlab.ListStateEventsOptions{ListOptions: opts})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list state events: %w", err).Error()), nil
+		}
+		if morePages(resp) {
+			truncated = append(truncated, "state")
+		}
+		milestones, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.MilestoneEvent, *gitlab.Response, error) {
+			return client.ResourceMilestoneEvents.ListMergeMilestoneEvents(pid, id, &gitlab.ListMilestoneEventsOptions{ListOptions: opts})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list milestone events: %w", err).Error()), nil
+		}
+		if morePages(resp) {
+			truncated = append(truncated, "milestone")
+		}
+
+		return historyResult(history{
+			Timeline:  buildHistory(labels, states, milestones, nil, nil),
+			Truncated: truncated,
+		})
+	}
+
+	return tool, handler
+}
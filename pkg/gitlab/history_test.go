@@ -0,0 +1,272 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockResourceLabelEventsService is a mock implementation of the GitLab resource label events service
+type mockResourceLabelEventsService struct {
+	listIssueFunc func(pid interface{}, issue int, opt *gitlab.ListLabelEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.LabelEvent, *gitlab.Response, error)
+}
+
+// ensure mockResourceLabelEventsService implements the gitlab.ResourceLabelEventsServiceInterface
+var _ gitlab.ResourceLabelEventsServiceInterface = &mockResourceLabelEventsService{}
+
+func (m *mockResourceLabelEventsService) ListIssueLabelEvents(pid interface{}, issue int, opt *gitlab.ListLabelEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.LabelEvent, *gitlab.Response, error) {
+	return m.listIssueFunc(pid, issue, opt, options...)
+}
+
+func (m *mockResourceLabelEventsService) GetGroupEpicLabelEvent(gid interface{}, epic int, event int, options ...gitlab.RequestOptionFunc) (*gitlab.LabelEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockResourceLabelEventsService) GetIssueLabelEvent(pid interface{}, issue int, event int, options ...gitlab.RequestOptionFunc) (*gitlab.LabelEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockResourceLabelEventsService) GetMergeRequestLabelEvent(pid interface{}, request int, event int, options ...gitlab.RequestOptionFunc) (*gitlab.LabelEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockResourceLabelEventsService) ListGroupEpicLabelEvents(gid interface{}, epic int, opt *gitlab.ListLabelEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.LabelEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockResourceLabelEventsService) ListMergeRequestsLabelEvents(pid interface{}, request int, opt *gitlab.ListLabelEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.LabelEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+// mockResourceStateEventsService is a mock implementation of the GitLab resource state events service
+type mockResourceStateEventsService struct {
+	listIssueFunc func(pid interface{}, issue int, opt *gitlab.ListStateEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.StateEvent, *gitlab.Response, error)
+}
+
+// ensure mockResourceStateEventsService implements the gitlab.ResourceStateEventsServiceInterface
+var _ gitlab.ResourceStateEventsServiceInterface = &mockResourceStateEventsService{}
+
+func (m *mockResourceStateEventsService) ListIssueStateEvents(pid interface{}, issue int, opt *gitlab.ListStateEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.StateEvent, *gitlab.Response, error) {
+	return m.listIssueFunc(pid, issue, opt, options...)
+}
+
+func (m *mockResourceStateEventsService) GetIssueStateEvent(pid interface{}, issue int, event int, options ...gitlab.RequestOptionFunc) (*gitlab.StateEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockResourceStateEventsService) GetMergeRequestStateEvent(pid interface{}, request int, event int, options ...gitlab.RequestOptionFunc) (*gitlab.StateEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockResourceStateEventsService) ListMergeStateEvents(pid interface{}, request int, opt *gitlab.ListStateEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.StateEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+// mockResourceMilestoneEventsService is a mock implementation of the GitLab resource milestone events service
+type mockResourceMilestoneEventsService struct {
+	listIssueFunc func(pid interface{}, issue int, opt *gitlab.ListMilestoneEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.MilestoneEvent, *gitlab.Response, error)
+}
+
+// ensure mockResourceMilestoneEventsService implements the gitlab.ResourceMilestoneEventsServiceInterface
+var _ gitlab.ResourceMilestoneEventsServiceInterface = &mockResourceMilestoneEventsService{}
+
+func (m *mockResourceMilestoneEventsService) ListIssueMilestoneEvents(pid interface{}, issue int, opt *gitlab.ListMilestoneEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.MilestoneEvent, *gitlab.Response, error) {
+	return m.listIssueFunc(pid, issue, opt, options...)
+}
+
+func (m *mockResourceMilestoneEventsService) GetIssueMilestoneEvent(pid interface{}, issue int, event int, options ...gitlab.RequestOptionFunc) (*gitlab.MilestoneEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockResourceMilestoneEventsService) GetMergeRequestMilestoneEvent(pid interface{}, request int, event int, options ...gitlab.RequestOptionFunc) (*gitlab.MilestoneEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockResourceMilestoneEventsService) ListMergeMilestoneEvents(pid interface{}, request int, opt *gitlab.ListMilestoneEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.MilestoneEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+// mockResourceWeightEventsService is a mock implementation of the GitLab resource weight events service
+type mockResourceWeightEventsService struct {
+	listIssueFunc func(pid interface{}, issue int, opt *gitlab.ListWeightEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.WeightEvent, *gitlab.Response, error)
+}
+
+// ensure mockResourceWeightEventsService implements the gitlab.ResourceWeightEventsServiceInterface
+var _ gitlab.ResourceWeightEventsServiceInterface = &mockResourceWeightEventsService{}
+
+func (m *mockResourceWeightEventsService) ListIssueWeightEvents(pid interface{}, issue int, opt *gitlab.ListWeightEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.WeightEvent, *gitlab.Response, error) {
+	return m.listIssueFunc(pid, issue, opt, options...)
+}
+
+// mockResourceIterationEventsService is a mock implementation of the GitLab resource iteration events service
+type mockResourceIterationEventsService struct {
+	listIssueFunc func(pid interface{}, issue int, opt *gitlab.ListIterationEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.IterationEvent, *gitlab.Response, error)
+}
+
+// ensure mockResourceIterationEventsService implements the gitlab.ResourceIterationEventsServiceInterface
+var _ gitlab.ResourceIterationEventsServiceInterface = &mockResourceIterationEventsService{}
+
+func (m *mockResourceIterationEventsService) ListIssueIterationEvents(pid interface{}, issue int, opt *gitlab.ListIterationEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.IterationEvent, *gitlab.Response, error) {
+	return m.listIssueFunc(pid, issue, opt, options...)
+}
+
+func (m *mockResourceIterationEventsService) GetIssueIterationEvent(pid interface{}, issue int, event int, options ...gitlab.RequestOptionFunc) (*gitlab.IterationEvent, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+// newLabelEvent builds a label event, whose user and label are anonymous structs in the client library
+func newLabelEvent(action string, at time.Time, username string, label string) *gitlab.LabelEvent {
+	event := &gitlab.LabelEvent{Action: action, CreatedAt: &at}
+	event.User.Username = username
+	event.Label.Name = label
+	return event
+}
+
+func TestBuildHistory(t *testing.T) {
+	t1 := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	t2 := time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC)
+	t3 := time.Date(2024, 5, 3, 9, 0, 0, 0, time.UTC)
+	t4 := time.Date(2024, 5, 4, 9, 0, 0, 0, time.UTC)
+	alice := &gitlab.BasicUser{Username: "alice"}
+
+	labels := []*gitlab.LabelEvent{
+		newLabelEvent("add", t1, "alice", "bug"),
+		newLabelEvent("add", t3, "bob", "P1"),
+		newLabelEvent("remove", t3, "bob", "P2"),
+	}
+	states := []*gitlab.StateEvent{
+		{User: alice, CreatedAt: &t4, State: gitlab.ClosedEventType},
+	}
+	milestones := []*gitlab.MilestoneEvent{
+		{User: alice, CreatedAt: &t2, Action: "add", Milestone: &gitlab.Milestone{Title: "v1"}},
+	}
+	weights := []*gitlab.WeightEvent{
+		{User: alice, CreatedAt: &t2, Weight: 3},
+	}
+	iterations := []*gitlab.IterationEvent{
+		{User: alice, CreatedAt: &t4, Action: "remove", Iteration: &gitlab.Iteration{Title: "Sprint 4"}},
+	}
+
+	expected := []historyEntry{
+		{At: &t1, Actor: "alice", Kind: "label", Change: `added ~"bug"`},
+		{At: &t2, Actor: "alice", Kind: "milestone", Change: `set milestone %"v1"`},
+		{At: &t2, Actor: "alice", Kind: "weight", Change: "set weight to 3"},
+		{At: &t3, Actor: "bob", Kind: "label", Change: `added ~"P1", removed ~"P2"`},
+		{At: &t4, Actor: "alice", Kind: "state", Change: "closed"},
+		{At: &t4, Actor: "alice", Kind: "iteration", Change: `removed iteration "Sprint 4"`},
+	}
+
+	assert.Equal(t, expected, buildHistory(labels, states, milestones, weights, iterations))
+}
+
+func TestGetIssueHistory(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	closedAt := time.Date(2024, 5, 8, 9, 0, 0, 0, time.UTC)
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+	notFound := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	tests := []struct {
+		name          string
+		labelError    error
+		weightResp    *gitlab.Response
+		weightError   error
+		expected      history
+		expectedError string
+	}{
+		{
+			name:       "timeline merged across event kinds",
+			weightResp: ok,
+			expected: history{
+				Timeline: []historyEntry{
+					{At: &createdAt, Actor: "alice", Kind: "label", Change: `added ~"bug"`},
+					{At: &closedAt, Actor: "bob", Kind: "state", Change: "closed"},
+				},
+			},
+		},
+		{
+			name:        "weight events not available",
+			weightResp:  notFound,
+			weightError: fmt.Errorf("404 Not Found"),
+			expected: history{
+				Timeline: []historyEntry{
+					{At: &createdAt, Actor: "alice", Kind: "label", Change: `added ~"bug"`},
+					{At: &closedAt, Actor: "bob", Kind: "state", Change: "closed"},
+				},
+				Unavailable: []string{"weight"},
+			},
+		},
+		{
+			name:          "GitLab API error",
+			labelError:    fmt.Errorf("API error"),
+			weightResp:    ok,
+			expectedError: "failed to list label events: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					ResourceLabelEvents: &mockResourceLabelEventsService{
+						listIssueFunc: func(pid interface{}, issue int, opt *gitlab.ListLabelEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.LabelEvent, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, 7, issue)
+							return []*gitlab.LabelEvent{newLabelEvent("add", createdAt, "alice", "bug")}, ok, tc.labelError
+						},
+					},
+					ResourceStateEvents: &mockResourceStateEventsService{
+						listIssueFunc: func(pid interface{}, issue int, opt *gitlab.ListStateEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.StateEvent, *gitlab.Response, error) {
+							return []*gitlab.StateEvent{{User: &gitlab.BasicUser{Username: "bob"}, CreatedAt: &closedAt, State: gitlab.ClosedEventType}}, ok, nil
+						},
+					},
+					ResourceMilestoneEvents: &mockResourceMilestoneEventsService{
+						listIssueFunc: func(pid interface{}, issue int, opt *gitlab.ListMilestoneEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.MilestoneEvent, *gitlab.Response, error) {
+							return nil, ok, nil
+						},
+					},
+					ResourceWeightEvents: &mockResourceWeightEventsService{
+						listIssueFunc: func(pid interface{}, issue int, opt *gitlab.ListWeightEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.WeightEvent, *gitlab.Response, error) {
+							return nil, tc.weightResp, tc.weightError
+						},
+					},
+					ResourceIterationEvents: &mockResourceIterationEventsService{
+						listIssueFunc: func(pid interface{}, issue int, opt *gitlab.ListIterationEventsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.IterationEvent, *gitlab.Response, error) {
+							return nil, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := GetIssueHistory(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"id":        float64(7),
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got history
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
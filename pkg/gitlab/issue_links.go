@@ -0,0 +1,286 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// issueLinkTypes are the relationships that can link two issues
+var issueLinkTypes = []string{"relates_to", "blocks", "is_blocked_by"}
+
+// issueLinks groups the issues linked to an issue by relationship, seen from that issue
+type issueLinks struct {
+	Blocks      []*gitlab.IssueRelation `json:"blocks"`
+	IsBlockedBy []*gitlab.IssueRelation `json:"is_blocked_by"`
+	RelatesTo   []*gitlab.IssueRelation `json:"relates_to"`
+}
+
+// groupIssueLinks sorts issue relations into their relationship
+func groupIssueLinks(relations []*gitlab.IssueRelation) issueLinks {
+	links := issueLinks{
+		Blocks:      []*gitlab.IssueRelation{},
+		IsBlockedBy: []*gitlab.IssueRelation{},
+		RelatesTo:   []*gitlab.IssueRelation{},
+	}
+	for _, relation := range relations {
+		switch relation.LinkType {
+		case "blocks":
+			links.Blocks = append(links.Blocks, relation)
+		case "is_blocked_by":
+			links.IsBlockedBy = append(links.IsBlockedBy, relation)
+		default:
+			links.RelatesTo = append(links.RelatesTo, relation)
+		}
+	}
+	return links
+}
+
+// describeIssueRelation renders a linked issue as one line, including who it is assigned to
+func describeIssueRelation(relation *gitlab.IssueRelation) string {
+	reference := fmt.Sprintf("#%d", relation.IID)
+	if relation.References != nil && relation.References.Full != "" {
+		reference = relation.References.Full
+	}
+
+	assignees := make([]string, 0, len(relation.Assignees))
+	for _, assignee := range relation.Assignees {
+		assignees = append(assignees, assignee.Username)
+	}
+	owner := "unassigned"
+	if len(assignees) > 0 {
+		owner = "assigned to " + strings.Join(assignees, ", ")
+	}
+
+	return fmt.Sprintf("%s %s %q (%s, %s)", strings.ReplaceAll(relation.LinkType, "_", " "), reference, relation.Title, relation.State, owner)
+}
+
+// describeClosingMergeRequest renders a merge request that closes an issue as one line
+func describeClosingMergeRequest(mr *gitlab.BasicMergeRequest) string {
+	reference := fmt.Sprintf("!%d", mr.IID)
+	if mr.References != nil && mr.References.Full != "" {
+		reference = mr.References.Full
+	}
+	return fmt.Sprintf("%s %q (%s)", reference, mr.Title, mr.State)
+}
+
+// ListIssueLinks returns a tool for listing the issues linked to an issue
+func ListIssueLinks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_issue_links",
+		mcp.WithDescription(t("TOOL_LIST_ISSUE_LINKS_DESCRIPTION", "List the issues linked to an issue, grouped into the issues it blocks, the issues blocking it and related issues")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ISSUE_ID_DESCRIPTION", "The ID of the issue")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		issueID, err := RequiredInt(r, "id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		relations, _, err := client.IssueLinks.ListIssueRelations(fmt.Sprintf("%s/%s", namespace, project), issueID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list issue links: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(groupIssueLinks(relations))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// CreateIssueLink returns a tool for linking two issues
+func CreateIssueLink(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"create_issue_link",
+		mcp.WithDescription(t("TOOL_CREATE_ISSUE_LINK_DESCRIPTION", "Link an issue to another issue, optionally in another project")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ISSUE_ID_DESCRIPTION", "The ID of the issue")),
+		),
+		mcp.WithNumber("target_issue_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_TARGET_ISSUE_ID_DESCRIPTION", "The ID of the issue to link to")),
+		),
+		mcp.WithString("target_namespace",
+			mcp.Description(t("PARAM_TARGET_NAMESPACE_DESCRIPTION", "The namespace of the target issue's project. Defaults to namespace")),
+		),
+		mcp.WithString("target_project",
+			mcp.Description(t("PARAM_TARGET_PROJECT_DESCRIPTION", "The name of the target issue's project. Defaults to project")),
+		),
+		mcp.WithString("link_type",
+			mcp.Description(t("PARAM_LINK_TYPE_DESCRIPTION", "How the issue relates to the target issue. Defaults to relates_to")),
+			mcp.Enum(issueLinkTypes...),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		issueID, err := RequiredInt(r, "id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		targetIssueID, err := RequiredInt(r, "target_issue_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		targetNamespace, err := OptionalParam[string](r, "target_namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		targetProject, err := OptionalParam[string](r, "target_project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		linkType, err := OptionalParam[string](r, "link_type")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if targetNamespace == "" {
+			targetNamespace = namespace
+		}
+		if targetProject == "" {
+			targetProject = project
+		}
+		if linkType == "" {
+			linkType = "relates_to"
+		}
+
+		link, _, err := client.IssueLinks.CreateIssueLink(fmt.Sprintf("%s/%s", namespace, project), issueID, &gitlab.CreateIssueLinkOptions{
+			TargetProjectID: gitlab.Ptr(fmt.Sprintf("%s/%s", targetNamespace, targetProject)),
+			TargetIssueIID:  gitlab.Ptr(strconv.Itoa(targetIssueID)),
+			LinkType:        gitlab.Ptr(linkType),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to create issue link: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(link)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// DeleteIssueLink returns a tool for removing a link between two issues
+func DeleteIssueLink(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"delete_issue_link",
+		mcp.WithDescription(t("TOOL_DELETE_ISSUE_LINK_DESCRIPTION", "Remove a link between two issues")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ISSUE_ID_DESCRIPTION", "The ID of the issue")),
+		),
+		mcp.WithNumber("issue_link_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ISSUE_LINK_ID_DESCRIPTION", "The ID of the link, as returned by list_issue_links")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		issueID, err := RequiredInt(r, "id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		linkID, err := RequiredInt(r, "issue_link_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		link, _, err := client.IssueLinks.DeleteIssueLink(fmt.Sprintf("%s/%s", namespace, project), issueID, linkID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to delete issue link: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(link)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
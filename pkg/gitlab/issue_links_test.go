@@ -0,0 +1,158 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockIssueLinksService is a mock implementation of the GitLab issue links service
+type mockIssueLinksService struct {
+	listFunc func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error)
+}
+
+// ensure mockIssueLinksService implements the gitlab.IssueLinksServiceInterface
+var _ gitlab.IssueLinksServiceInterface = &mockIssueLinksService{}
+
+func (m *mockIssueLinksService) ListIssueRelations(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error) {
+	return m.listFunc(pid, issue, options...)
+}
+
+func (m *mockIssueLinksService) CreateIssueLink(pid interface{}, issue int, opt *gitlab.CreateIssueLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockIssueLinksService) DeleteIssueLink(pid interface{}, issue, issueLink int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockIssueLinksService) GetIssueLink(pid interface{}, issue, issueLink int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestDescribeIssueRelation(t *testing.T) {
+	tests := []struct {
+		name     string
+		relation *gitlab.IssueRelation
+		expected string
+	}{
+		{
+			name: "assigned with full reference",
+			relation: &gitlab.IssueRelation{
+				IID:        7,
+				Title:      "Database migration",
+				State:      "opened",
+				LinkType:   "is_blocked_by",
+				References: &gitlab.IssueReferences{Full: "group/backend#7"},
+				Assignees:  []*gitlab.IssueAssignee{{Username: "alice"}, {Username: "bob"}},
+			},
+			expected: `is blocked by group/backend#7 "Database migration" (opened, assigned to alice, bob)`,
+		},
+		{
+			name: "unassigned without references",
+			relation: &gitlab.IssueRelation{
+				IID:      3,
+				Title:    "Docs",
+				State:    "closed",
+				LinkType: "relates_to",
+			},
+			expected: `relates to #3 "Docs" (closed, unassigned)`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, describeIssueRelation(tc.relation))
+		})
+	}
+}
+
+func TestListIssueLinks(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mockResponse  []*gitlab.IssueRelation
+		mockError     error
+		expected      issueLinks
+		expectedError string
+	}{
+		{
+			name: "links grouped by type",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"id":        float64(1),
+			},
+			mockResponse: []*gitlab.IssueRelation{
+				{IID: 2, LinkType: "blocks"},
+				{IID: 3, LinkType: "is_blocked_by"},
+				{IID: 4, LinkType: "relates_to"},
+				{IID: 5, LinkType: "is_blocked_by"},
+			},
+			expected: issueLinks{
+				Blocks:      []*gitlab.IssueRelation{{IID: 2, LinkType: "blocks"}},
+				IsBlockedBy: []*gitlab.IssueRelation{{IID: 3, LinkType: "is_blocked_by"}, {IID: 5, LinkType: "is_blocked_by"}},
+				RelatesTo:   []*gitlab.IssueRelation{{IID: 4, LinkType: "relates_to"}},
+			},
+		},
+		{
+			name: "missing issue id",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+			},
+			expectedError: "missing required parameter: id",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"id":        float64(1),
+			},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to list issue links: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					IssueLinks: &mockIssueLinksService{
+						listFunc: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error) {
+							assert.Equal(t, "test-namespace/test-project", pid)
+							return tc.mockResponse, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListIssueLinks(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got issueLinks
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -69,11 +71,75 @@ func OptionalParam[T any](r mcp.CallToolRequest, p string) (T, error) {
 	return r.Params.Arguments[p].(T), nil
 }
 
+// optionalPtrParam fetches an optional parameter like OptionalParam, but returns nil when it is missing.
+// It tells an explicit false or empty string apart from a parameter that was not given.
+func optionalPtrParam[T any](r mcp.CallToolRequest, p string) (*T, error) {
+	if _, ok := r.Params.Arguments[p]; !ok {
+		return nil, nil
+	}
+	v, err := OptionalParam[T](r, p)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// OptionalInt is a helper function that can be used to fetch an optional integer parameter from the request.
+// Numbers arrive as float64 over JSON, so the value is converted after the type check.
+func OptionalInt(r mcp.CallToolRequest, p string) (int, error) {
+	v, err := OptionalParam[float64](r, p)
+	if err != nil {
+		return 0, err
+	}
+	return int(v), nil
+}
+
+// OptionalStringArrayParam is a helper function that can be used to fetch an optional array of strings from the request.
+// JSON arrays arrive as []interface{}, so every element is checked to be a string.
+func OptionalStringArrayParam(r mcp.CallToolRequest, p string) ([]string, error) {
+	v, ok := r.Params.Arguments[p]
+	if !ok || v == nil {
+		return nil, nil
+	}
+
+	switch values := v.(type) {
+	case []string:
+		return values, nil
+	case []interface{}:
+		strs := make([]string, 0, len(values))
+		for _, value := range values {
+			str, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("parameter %s is not of type []string, contains %T", p, value)
+			}
+			strs = append(strs, str)
+		}
+		return strs, nil
+	default:
+		return nil, fmt.Errorf("parameter %s is not of type []string, is %T", p, v)
+	}
+}
+
+// OptionalTime is a helper function that can be used to fetch an optional timestamp parameter from the request.
+// Both RFC 3339 timestamps and plain dates (YYYY-MM-DD) are accepted. A missing parameter returns nil.
+func OptionalTime(r mcp.CallToolRequest, p string) (*time.Time, error) {
+	v, err := OptionalParam[string](r, p)
+	if err != nil || v == "" {
+		return nil, err
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if ts, err := time.Parse(layout, v); err == nil {
+			return &ts, nil
+		}
+	}
+	return nil, fmt.Errorf("parameter %s is not a valid date or RFC 3339 timestamp: %s", p, v)
+}
+
 // GetIssue returns a tool for getting a specific issue
 func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	tool = mcp.NewTool(
 		"get_issue",
-		mcp.WithDescription(t("TOOL_GET_ISSUE_DESCRIPTION", "Get a specific issue")),
+		mcp.WithDescription(t("TOOL_GET_ISSUE_DESCRIPTION", "Get a specific issue, with its linked issues and the merge requests that close it")),
 		mcp.WithString("namespace",
 			mcp.Required(),
 			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
@@ -115,11 +181,34 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 			return nil, fmt.Errorf("failed to get issue: %w", err)
 		}
 
+		// links and closing merge requests are extras: an instance or tier without them still returns the issue
+		relations, _, relationsErr := client.IssueLinks.ListIssueRelations(projectID, int(issueID))
+		closingMRs, _, closingErr := client.Issues.ListMergeRequestsClosingIssue(projectID, int(issueID), nil)
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "Title: %s\nState: %s\nAuthor: %s", issue.Title, issue.State, issue.Author.Name)
+		if relationsErr != nil {
+			fmt.Fprintf(&text, "\nLinked issues: unavailable (%v)", relationsErr)
+		} else if len(relations) > 0 {
+			text.WriteString("\nLinked issues:")
+			for _, relation := range relations {
+				fmt.Fprintf(&text, "\n- %s", describeIssueRelation(relation))
+			}
+		}
+		if closingErr != nil {
+			fmt.Fprintf(&text, "\nClosed by merge requests: unavailable (%v)", closingErr)
+		} else if len(closingMRs) > 0 {
+			text.WriteString("\nClosed by merge requests:")
+			for _, mr := range closingMRs {
+				fmt.Fprintf(&text, "\n- %s", describeClosingMergeRequest(mr))
+			}
+		}
+
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{
 					Type: "text",
-					Text: fmt.Sprintf("Title: %s\nState: %s\nAuthor: %s", issue.Title, issue.State, issue.Author.Name),
+					Text: text.String(),
 				},
 			},
 		}, nil
@@ -17,6 +17,9 @@ import (
 type mockIssuesService struct {
 	getFunc         func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
 	listProjectFunc func(pid interface{}, opt *gitlab.ListProjectIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error)
+	updateFunc      func(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	addSpentFunc    func(pid interface{}, issue int, opt *gitlab.AddSpentTimeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+	listClosingFunc func(pid interface{}, issue int, opt *gitlab.ListMergeRequestsClosingIssueOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error)
 }
 
 func (m *mockIssuesService) GetIssue(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
@@ -28,7 +31,7 @@ func (m *mockIssuesService) ListProjectIssues(pid interface{}, opt *gitlab.ListP
 }
 
 func (m *mockIssuesService) AddSpentTime(pid interface{}, issue int, opt *gitlab.AddSpentTimeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.addSpentFunc(pid, issue, opt, options...)
 }
 
 func (m *mockIssuesService) CreateIssue(pid interface{}, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
@@ -64,7 +67,7 @@ func (m *mockIssuesService) ListIssues(opt *gitlab.ListIssuesOptions, options ..
 }
 
 func (m *mockIssuesService) ListMergeRequestsClosingIssue(pid interface{}, issue int, opt *gitlab.ListMergeRequestsClosingIssueOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.listClosingFunc(pid, issue, opt, options...)
 }
 
 func (m *mockIssuesService) ListMergeRequestsRelatedToIssue(pid interface{}, issue int, opt *gitlab.ListMergeRequestsRelatedToIssueOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
@@ -100,16 +103,20 @@ func (m *mockIssuesService) UnsubscribeFromIssue(pid interface{}, issue int, opt
 }
 
 func (m *mockIssuesService) UpdateIssue(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.updateFunc(pid, issue, opt, options...)
 }
 
 func TestGetIssue(t *testing.T) {
 	tests := []struct {
-		name          string
-		args          map[string]interface{}
-		mockResponse  *gitlab.Issue
-		mockError     error
-		expectedError string
+		name           string
+		args           map[string]interface{}
+		mockResponse   *gitlab.Issue
+		mockLinks      []*gitlab.IssueRelation
+		mockClosingMRs []*gitlab.BasicMergeRequest
+		mockLinksError error
+		mockError      error
+		expectedText   []string
+		expectedError  string
 	}{
 		{
 			name: "successful get issue",
@@ -128,6 +135,56 @@ func TestGetIssue(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "issue with links and closing merge requests",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"id":        float64(1),
+			},
+			mockResponse: &gitlab.Issue{
+				IID:   1,
+				Title: "Test Issue",
+				State: "opened",
+				Author: &gitlab.IssueAuthor{
+					Name: "Test User",
+				},
+			},
+			mockLinks: []*gitlab.IssueRelation{
+				{IID: 2, Title: "Blocker", State: "opened", LinkType: "is_blocked_by", Assignees: []*gitlab.IssueAssignee{{Username: "alice"}}},
+			},
+			mockClosingMRs: []*gitlab.BasicMergeRequest{
+				{IID: 5, Title: "Fix it", State: "merged"},
+			},
+			expectedText: []string{
+				"Linked issues:\n- is blocked by #2 \"Blocker\" (opened, assigned to alice)",
+				"Closed by merge requests:\n- !5 \"Fix it\" (merged)",
+			},
+		},
+		{
+			name: "issue links unavailable",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"id":        float64(1),
+			},
+			mockResponse: &gitlab.Issue{
+				IID:   1,
+				Title: "Test Issue",
+				State: "opened",
+				Author: &gitlab.IssueAuthor{
+					Name: "Test User",
+				},
+			},
+			mockLinksError: fmt.Errorf("403 Forbidden"),
+			mockClosingMRs: []*gitlab.BasicMergeRequest{
+				{IID: 5, Title: "Fix it", State: "merged"},
+			},
+			expectedText: []string{
+				"Linked issues: unavailable (403 Forbidden)",
+				"Closed by merge requests:\n- !5 \"Fix it\" (merged)",
+			},
+		},
 		{
 			name: "missing required parameter",
 			args: map[string]interface{}{
@@ -166,6 +223,14 @@ func TestGetIssue(t *testing.T) {
 						getFunc: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
 							return tc.mockResponse, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, tc.mockError
 						},
+						listClosingFunc: func(pid interface{}, issue int, opt *gitlab.ListMergeRequestsClosingIssueOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+							return tc.mockClosingMRs, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+					},
+					IssueLinks: &mockIssueLinksService{
+						listFunc: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error) {
+							return tc.mockLinks, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, tc.mockLinksError
+						},
 					},
 				}, nil
 			}
@@ -209,6 +274,9 @@ func TestGetIssue(t *testing.T) {
 			assert.Contains(t, textContent.Text, tc.mockResponse.Title)
 			assert.Contains(t, textContent.Text, tc.mockResponse.State)
 			assert.Contains(t, textContent.Text, tc.mockResponse.Author.Name)
+			for _, expected := range tc.expectedText {
+				assert.Contains(t, textContent.Text, expected)
+			}
 		})
 	}
 }
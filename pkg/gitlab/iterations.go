@@ -0,0 +1,404 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// iterationStates maps GitLab's numeric iteration states to their names
+var iterationStates = map[int]string{
+	1: "upcoming",
+	2: "current",
+	3: "closed",
+}
+
+// iterationSummary is an iteration with its state spelled out
+type iterationSummary struct {
+	*gitlab.GroupIteration
+	StateName string `json:"state_name"`
+}
+
+// summarizeIteration names the numeric state of an iteration
+func summarizeIteration(iteration *gitlab.GroupIteration) iterationSummary {
+	return iterationSummary{
+		GroupIteration: iteration,
+		StateName:      iterationStates[iteration.State],
+	}
+}
+
+// listIterations lists the iterations of a project, or of the namespace group when project is empty.
+// Project iterations are the iterations of the project's ancestor groups, so both share one type.
+func listIterations(client *gitlab.Client, namespace string, project string, state *string, search *string, includeAncestors *bool, pagination gitlab.ListOptions) ([]*gitlab.GroupIteration, *gitlab.Response, error) {
+	if project != "" {
+		projectIterations, resp, err := client.ProjectIterations.ListProjectIterations(fmt.Sprintf("%s/%s", namespace, project), &gitlab.ListProjectIterationsOptions{
+			ListOptions:      pagination,
+			State:            state,
+			Search:           search,
+			IncludeAncestors: includeAncestors,
+		})
+		iterations := make([]*gitlab.GroupIteration, 0, len(projectIterations))
+		for _, iteration := range projectIterations {
+			iterations = append(iterations, (*gitlab.GroupIteration)(iteration))
+		}
+		return iterations, resp, err
+	}
+
+	return client.GroupIterations.ListGroupIterations(namespace, &gitlab.ListGroupIterationsOptions{
+		ListOptions:      pagination,
+		State:            state,
+		Search:           search,
+		IncludeAncestors: includeAncestors,
+	})
+}
+
+// ListIterations returns a tool for listing the iterations of a project or group
+func ListIterations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_iterations",
+		mcp.WithDescription(t("TOOL_LIST_ITERATIONS_DESCRIPTION", "List the iterations of a project or group")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_ITERATION_PROJECT_DESCRIPTION", "The name of the project. Omit to use the iterations of the namespace group")),
+		),
+		mcp.WithString("state",
+			mcp.Description(t("PARAM_ITERATION_STATE_DESCRIPTION", "Only return iterations in this state")),
+			mcp.Enum("opened", "upcoming", "current", "closed", "all"),
+		),
+		mcp.WithString("search",
+			mcp.Description(t("PARAM_ITERATION_SEARCH_DESCRIPTION", "Only return iterations with a title containing this text")),
+		),
+		mcp.WithBoolean("include_ancestors",
+			mcp.Description(t("PARAM_ITERATION_INCLUDE_ANCESTORS_DESCRIPTION", "Include iterations of ancestor groups")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := OptionalParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var state, search *string
+		v, err := OptionalParam[string](r, "state")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if v != "" {
+			state = &v
+		}
+		v, err = OptionalParam[string](r, "search")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if v != "" {
+			search = &v
+		}
+		var includeAncestors *bool
+		if _, ok := r.Params.Arguments["include_ancestors"]; ok {
+			v, err := OptionalParam[bool](r, "include_ancestors")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeAncestors = &v
+		}
+
+		iterations, _, err := listIterations(client, namespace, project, state, search, includeAncestors, pagination)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list iterations: %w", err).Error()), nil
+		}
+
+		summaries := make([]iterationSummary, 0, len(iterations))
+		for _, iteration := range iterations {
+			summaries = append(summaries, summarizeIteration(iteration))
+		}
+
+		jsonData, err := json.Marshal(summaries)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// GetIteration returns a tool for getting a single iteration of a project or group.
+// The REST API has no endpoint for a single iteration, so the iterations are scanned for the ID.
+func GetIteration(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_iteration",
+		mcp.WithDescription(t("TOOL_GET_ITERATION_DESCRIPTION", "Get an iteration of a project or group by its ID")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_ITERATION_PROJECT_DESCRIPTION", "The name of the project. Omit to use the iterations of the namespace group")),
+		),
+		mcp.WithNumber("iteration_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ITERATION_ID_DESCRIPTION", "The ID of the iteration")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := OptionalParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := RequiredInt(r, "iteration_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		iterations, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.GroupIteration, *gitlab.Response, error) {
+			return listIterations(client, namespace, project, gitlab.Ptr("all"), nil, gitlab.Ptr(true), opts)
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list iterations: %w", err).Error()), nil
+		}
+
+		for _, iteration := range iterations {
+			if iteration.ID != id {
+				continue
+			}
+
+			jsonData, err := json.Marshal(summarizeIteration(iteration))
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+			}
+			return mcp.NewToolResultText(string(jsonData)), nil
+		}
+
+		if morePages(resp) {
+			return mcp.NewToolResultError(fmt.Sprintf("iteration %d not found in the first %d iterations", id, len(iterations))), nil
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("iteration %d not found", id)), nil
+	}
+
+	return tool, handler
+}
+
+// graphQLError is an error returned by the GraphQL API
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+// graphQL runs a GraphQL query against the instance of the client and decodes its data into result.
+// The REST API has no endpoints for some operations, such as creating iterations.
+func graphQL(client *gitlab.Client, query string, variables map[string]interface{}, result interface{}) error {
+	req, err := client.NewRequest(http.MethodPost, "", map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	// the GraphQL endpoint lives next to the versioned REST API, e.g. /api/graphql
+	req.URL = client.BaseURL().ResolveReference(&url.URL{Path: "../graphql"})
+
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []graphQLError  `json:"errors"`
+	}
+	if _, err := client.Do(req, &response); err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		messages := make([]string, 0, len(response.Errors))
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("%s", strings.Join(messages, "; "))
+	}
+	return json.Unmarshal(response.Data, result)
+}
+
+// createIterationMutation creates an iteration in a group
+const createIterationMutation = `mutation($input: iterationCreateInput!) {
+  iterationCreate(input: $input) {
+    iteration { id iid title description state startDate dueDate webUrl }
+    errors
+  }
+}`
+
+// createdIteration is an iteration as returned by the GraphQL API
+type createdIteration struct {
+	ID          int    `json:"id"`
+	IID         int    `json:"iid"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	StateName   string `json:"state_name"`
+	StartDate   string `json:"start_date"`
+	DueDate     string `json:"due_date"`
+	WebURL      string `json:"web_url"`
+}
+
+// globalIDNumber returns the number at the end of a GraphQL global ID such as gid://gitlab/Iteration/12
+func globalIDNumber(gid string) (int, error) {
+	return strconv.Atoi(gid[strings.LastIndex(gid, "/")+1:])
+}
+
+// CreateIteration returns a tool for creating an iteration in a group.
+// GitLab derives the state of an iteration from its dates, so there is no tool for closing one.
+func CreateIteration(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"create_iteration",
+		mcp.WithDescription(t("TOOL_CREATE_ITERATION_DESCRIPTION", "Create an iteration in a group. Iterations close on their own once their due date has passed")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ITERATION_GROUP_DESCRIPTION", "The path of the group")),
+		),
+		mcp.WithString("start_date",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ITERATION_START_DATE_DESCRIPTION", "The start date of the iteration (YYYY-MM-DD)")),
+		),
+		mcp.WithString("due_date",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ITERATION_DUE_DATE_DESCRIPTION", "The due date of the iteration (YYYY-MM-DD)")),
+		),
+		mcp.WithNumber("cadence_id",
+			mcp.Description(t("PARAM_ITERATION_CADENCE_ID_DESCRIPTION", "The ID of the iteration cadence, as shown in the URL of the cadence. Required on GitLab versions where iterations belong to a cadence")),
+		),
+		mcp.WithString("title",
+			mcp.Description(t("PARAM_ITERATION_TITLE_DESCRIPTION", "The title of the iteration")),
+		),
+		mcp.WithString("description",
+			mcp.Description(t("PARAM_ITERATION_DESCRIPTION_DESCRIPTION", "The description of the iteration")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		startDate, err := optionalISODate(r, "start_date")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		dueDate, err := optionalISODate(r, "due_date")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if startDate == nil || dueDate == nil {
+			return mcp.NewToolResultError("start_date and due_date are required"), nil
+		}
+		cadenceID, err := OptionalInt(r, "cadence_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		title, err := OptionalParam[string](r, "title")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		description, err := OptionalParam[string](r, "description")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		input := map[string]interface{}{
+			"groupPath": namespace,
+			"startDate": startDate.String(),
+			"dueDate":   dueDate.String(),
+		}
+		if cadenceID != 0 {
+			input["iterationsCadenceId"] = fmt.Sprintf("gid://gitlab/Iterations::Cadence/%d", cadenceID)
+		}
+		if title != "" {
+			input["title"] = title
+		}
+		if description != "" {
+			input["description"] = description
+		}
+
+		var data struct {
+			IterationCreate struct {
+				Iteration *struct {
+					ID          string `json:"id"`
+					IID         string `json:"iid"`
+					Title       string `json:"title"`
+					Description string `json:"description"`
+					State       string `json:"state"`
+					StartDate   string `json:"startDate"`
+					DueDate     string `json:"dueDate"`
+					WebURL      string `json:"webUrl"`
+				} `json:"iteration"`
+				Errors []string `json:"errors"`
+			} `json:"iterationCreate"`
+		}
+		if err := graphQL(client, createIterationMutation, map[string]interface{}{"input": input}, &data); err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to create iteration: %w", err).Error()), nil
+		}
+		if len(data.IterationCreate.Errors) > 0 || data.IterationCreate.Iteration == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to create iteration: %s", strings.Join(data.IterationCreate.Errors, "; "))), nil
+		}
+
+		iteration := data.IterationCreate.Iteration
+		id, err := globalIDNumber(iteration.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to read iteration ID %q: %w", iteration.ID, err).Error()), nil
+		}
+		iid, _ := strconv.Atoi(iteration.IID)
+
+		jsonData, err := json.Marshal(createdIteration{
+			ID:          id,
+			IID:         iid,
+			Title:       iteration.Title,
+			Description: iteration.Description,
+			StateName:   iteration.State,
+			StartDate:   iteration.StartDate,
+			DueDate:     iteration.DueDate,
+			WebURL:      iteration.WebURL,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
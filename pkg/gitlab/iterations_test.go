@@ -0,0 +1,203 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockGroupIterationsService is a mock implementation of the GitLab group iterations service
+type mockGroupIterationsService struct {
+	listFunc func(gid interface{}, opt *gitlab.ListGroupIterationsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupIteration, *gitlab.Response, error)
+}
+
+// ensure mockGroupIterationsService implements the gitlab.GroupIterationsServiceInterface
+var _ gitlab.GroupIterationsServiceInterface = &mockGroupIterationsService{}
+
+func (m *mockGroupIterationsService) ListGroupIterations(gid interface{}, opt *gitlab.ListGroupIterationsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupIteration, *gitlab.Response, error) {
+	return m.listFunc(gid, opt, options...)
+}
+
+func TestGetIteration(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedTitle string
+		expectedState string
+		expectedError string
+	}{
+		{
+			name: "found on the second page",
+			args: map[string]interface{}{
+				"namespace":    "test-group",
+				"iteration_id": float64(42),
+			},
+			expectedTitle: "Sprint 42",
+			expectedState: "current",
+		},
+		{
+			name: "not found",
+			args: map[string]interface{}{
+				"namespace":    "test-group",
+				"iteration_id": float64(7),
+			},
+			expectedError: "iteration 7 not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					GroupIterations: &mockGroupIterationsService{
+						listFunc: func(gid interface{}, opt *gitlab.ListGroupIterationsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupIteration, *gitlab.Response, error) {
+							assert.Equal(t, "test-group", gid)
+							assert.Equal(t, "all", *opt.State)
+							if opt.Page == 0 {
+								return []*gitlab.GroupIteration{{ID: 41, Title: "Sprint 41", State: 3}},
+									&gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}, NextPage: 2}, nil
+							}
+							return []*gitlab.GroupIteration{{ID: 42, Title: "Sprint 42", State: 2}},
+								&gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := GetIteration(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got iterationSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expectedTitle, got.Title)
+			assert.Equal(t, tc.expectedState, got.StateName)
+		})
+	}
+}
+
+func TestCreateIteration(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		response      string
+		expectedInput map[string]interface{}
+		expected      createdIteration
+		expectedError string
+	}{
+		{
+			name: "created in a cadence",
+			args: map[string]interface{}{
+				"namespace":  "test-group",
+				"start_date": "2025-03-03",
+				"due_date":   "2025-03-14",
+				"cadence_id": float64(4),
+				"title":      "Sprint 12",
+			},
+			response: `{"data":{"iterationCreate":{"iteration":{"id":"gid://gitlab/Iteration/55","iid":"12","title":"Sprint 12","state":"upcoming","startDate":"2025-03-03","dueDate":"2025-03-14","webUrl":"https://gitlab.example.com/groups/test-group/-/iterations/55"},"errors":[]}}}`,
+			expectedInput: map[string]interface{}{
+				"groupPath":           "test-group",
+				"startDate":           "2025-03-03",
+				"dueDate":             "2025-03-14",
+				"iterationsCadenceId": "gid://gitlab/Iterations::Cadence/4",
+				"title":               "Sprint 12",
+			},
+			expected: createdIteration{ID: 55, IID: 12, Title: "Sprint 12", StateName: "upcoming", StartDate: "2025-03-03", DueDate: "2025-03-14", WebURL: "https://gitlab.example.com/groups/test-group/-/iterations/55"},
+		},
+		{
+			name: "mutation errors",
+			args: map[string]interface{}{
+				"namespace":  "test-group",
+				"start_date": "2025-03-03",
+				"due_date":   "2025-03-14",
+			},
+			response:      `{"data":{"iterationCreate":{"iteration":null,"errors":["Dates cannot overlap with other existing Iterations within this iterations cadence"]}}}`,
+			expectedError: "Dates cannot overlap",
+		},
+		{
+			name: "query errors",
+			args: map[string]interface{}{
+				"namespace":  "test-group",
+				"start_date": "2025-03-03",
+				"due_date":   "2025-03-14",
+			},
+			response:      `{"errors":[{"message":"The resource that you are attempting to access does not exist"}]}`,
+			expectedError: "does not exist",
+		},
+		{
+			name: "missing due date",
+			args: map[string]interface{}{
+				"namespace":  "test-group",
+				"start_date": "2025-03-03",
+			},
+			expectedError: "start_date and due_date are required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/graphql", r.URL.Path)
+
+				var body struct {
+					Query     string `json:"query"`
+					Variables struct {
+						Input map[string]interface{} `json:"input"`
+					} `json:"variables"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Contains(t, body.Query, "iterationCreate")
+				if tc.expectedInput != nil {
+					assert.Equal(t, tc.expectedInput, body.Variables.Input)
+				}
+				fmt.Fprint(w, tc.response)
+			}))
+			defer srv.Close()
+
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := CreateIteration(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var got createdIteration
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
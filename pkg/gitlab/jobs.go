@@ -0,0 +1,321 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// jobSummary is a job with the fields needed to tell where it is in its pipeline
+type jobSummary struct {
+	ID            int        `json:"id"`
+	Name          string     `json:"name"`
+	Stage         string     `json:"stage"`
+	Status        string     `json:"status"`
+	FailureReason string     `json:"failure_reason,omitempty"`
+	AllowFailure  bool       `json:"allow_failure"`
+	Ref           string     `json:"ref"`
+	PipelineID    int        `json:"pipeline_id"`
+	User          string     `json:"user,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	StartedAt     *time.Time `json:"started_at,omitempty"`
+	FinishedAt    *time.Time `json:"finished_at,omitempty"`
+	Duration      float64    `json:"duration,omitempty"`
+	WebURL        string     `json:"web_url"`
+}
+
+// summarizeJob flattens a job
+func summarizeJob(job *gitlab.Job) jobSummary {
+	summary := jobSummary{
+		ID:            job.ID,
+		Name:          job.Name,
+		Stage:         job.Stage,
+		Status:        job.Status,
+		FailureReason: job.FailureReason,
+		AllowFailure:  job.AllowFailure,
+		Ref:           job.Ref,
+		PipelineID:    job.Pipeline.ID,
+		CreatedAt:     job.CreatedAt,
+		StartedAt:     job.StartedAt,
+		FinishedAt:    job.FinishedAt,
+		Duration:      job.Duration,
+		WebURL:        job.WebURL,
+	}
+	if job.User != nil {
+		summary.User = job.User.Username
+	}
+	return summary
+}
+
+// manualJobs is the response of list_manual_jobs
+type manualJobs struct {
+	Jobs []jobSummary `json:"jobs"`
+	// Truncated is set when the pipeline has more manual jobs than listAllPages reads
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// stringVariables converts variables given as a JSON object to the string values GitLab expects
+func stringVariables(variables map[string]interface{}) (map[string]string, error) {
+	if len(variables) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]string, len(variables))
+	for key, value := range variables {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("variable %s must be a string, got %T", key, value)
+		}
+		result[key] = s
+	}
+	return result, nil
+}
+
+// withJobTarget adds the parameters identifying a job
+func withJobTarget(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		)(tool)
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		)(tool)
+		mcp.WithNumber("job_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_JOB_ID_DESCRIPTION", "The ID of the job")),
+		)(tool)
+	}
+}
+
+// readJobTarget returns the project and the job ID from the request
+func readJobTarget(r mcp.CallToolRequest) (string, int, error) {
+	namespace, err := requiredParam[string](r, "namespace")
+	if err != nil {
+		return "", 0, err
+	}
+	project, err := requiredParam[string](r, "project")
+	if err != nil {
+		return "", 0, err
+	}
+	jobID, err := RequiredInt(r, "job_id")
+	if err != nil {
+		return "", 0, err
+	}
+	return fmt.Sprintf("%s/%s", namespace, project), jobID, nil
+}
+
+// jobResult marshals a job as the tool response
+func jobResult(job *gitlab.Job) (*mcp.CallToolResult, error) {
+	jsonData, err := json.Marshal(summarizeJob(job))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// ListManualJobs returns a tool for listing the manual jobs of a pipeline that are waiting to be played
+func ListManualJobs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_manual_jobs",
+		mcp.WithDescription(t("TOOL_LIST_MANUAL_JOBS_DESCRIPTION", "List the manual jobs of a pipeline that are waiting to be played, such as deploys")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("pipeline_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PIPELINE_ID_DESCRIPTION", "The ID of the pipeline")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pipelineID, err := RequiredInt(r, "pipeline_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		jobs, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.Job, *gitlab.Response, error) {
+			return client.Jobs.ListPipelineJobs(fmt.Sprintf("%s/%s", namespace, project), pipelineID, &gitlab.ListJobsOptions{
+				ListOptions: opts,
+				Scope:       &[]gitlab.BuildStateValue{gitlab.Manual},
+			})
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list pipeline jobs: %w", err).Error()), nil
+		}
+
+		result := manualJobs{Jobs: make([]jobSummary, 0, len(jobs)), Truncated: morePages(resp)}
+		for _, job := range jobs {
+			result.Jobs = append(result.Jobs, summarizeJob(job))
+		}
+
+		jsonData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// PlayJob returns a tool for starting a manual job
+func PlayJob(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"play_job",
+		mcp.WithDescription(t("TOOL_PLAY_JOB_DESCRIPTION", "Start a manual job, optionally with variables for this run")),
+		withJobTarget(t),
+		mcp.WithObject("variables",
+			mcp.Description(t("PARAM_JOB_VARIABLES_DESCRIPTION", "Variables for this run of the job, as an object of keys to string values")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		pid, jobID, err := readJobTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		rawVariables, err := OptionalParam[map[string]interface{}](r, "variables")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		variables, err := stringVariables(rawVariables)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.PlayJobOptions{}
+		if len(variables) > 0 {
+			attributes := make([]*gitlab.JobVariableOptions, 0, len(variables))
+			for key, value := range variables {
+				attributes = append(attributes, &gitlab.JobVariableOptions{Key: gitlab.Ptr(key), Value: gitlab.Ptr(value)})
+			}
+			opts.JobVariablesAttributes = &attributes
+		}
+
+		job, _, err := client.Jobs.PlayJob(pid, jobID, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to play job: %w", err).Error()), nil
+		}
+		return jobResult(job)
+	}
+
+	return tool, handler
+}
+
+// RetryJob returns a tool for retrying a job
+func RetryJob(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"retry_job",
+		mcp.WithDescription(t("TOOL_RETRY_JOB_DESCRIPTION", "Retry a finished job. Returns the new job")),
+		withJobTarget(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		pid, jobID, err := readJobTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		job, _, err := client.Jobs.RetryJob(pid, jobID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to retry job: %w", err).Error()), nil
+		}
+		return jobResult(job)
+	}
+
+	return tool, handler
+}
+
+// CancelJob returns a tool for canceling a job
+func CancelJob(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"cancel_job",
+		mcp.WithDescription(t("TOOL_CANCEL_JOB_DESCRIPTION", "Cancel a pending or running job")),
+		withJobTarget(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		pid, jobID, err := readJobTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		job, _, err := client.Jobs.CancelJob(pid, jobID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to cancel job: %w", err).Error()), nil
+		}
+		return jobResult(job)
+	}
+
+	return tool, handler
+}
+
+// EraseJob returns a tool for erasing the log and artifacts of a job
+func EraseJob(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"erase_job",
+		mcp.WithDescription(t("TOOL_ERASE_JOB_DESCRIPTION", "Erase the log and artifacts of a finished job. This cannot be undone")),
+		withJobTarget(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		pid, jobID, err := readJobTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		job, _, err := client.Jobs.EraseJob(pid, jobID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to erase job: %w", err).Error()), nil
+		}
+		return jobResult(job)
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,210 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockJobsService is a mock implementation of the GitLab jobs service
+type mockJobsService struct {
+	getFunc              func(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error)
+	getArtifactsFunc     func(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error)
+	playFunc             func(pid interface{}, jobID int, opt *gitlab.PlayJobOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error)
+	listPipelineJobsFunc func(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error)
+}
+
+// ensure mockJobsService implements the gitlab.JobsServiceInterface
+var _ gitlab.JobsServiceInterface = &mockJobsService{}
+
+func (m *mockJobsService) GetJob(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error) {
+	return m.getFunc(pid, jobID, options...)
+}
+
+func (m *mockJobsService) GetJobArtifacts(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+	return m.getArtifactsFunc(pid, jobID, options...)
+}
+
+func (m *mockJobsService) DownloadSingleArtifactsFileByTagOrBranch(pid interface{}, refName string, artifactPath string, opt *gitlab.DownloadArtifactsFileOptions, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockJobsService) CancelJob(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockJobsService) DeleteArtifacts(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockJobsService) DeleteProjectArtifacts(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockJobsService) DownloadArtifactsFile(pid interface{}, refName string, opt *gitlab.DownloadArtifactsFileOptions, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockJobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, artifactPath string, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockJobsService) EraseJob(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockJobsService) GetJobTokensJob(opts *gitlab.GetJobTokensJobOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockJobsService) GetTraceFile(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*bytes.Reader, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockJobsService) KeepArtifacts(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockJobsService) ListPipelineBridges(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Bridge, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockJobsService) ListPipelineJobs(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+	return m.listPipelineJobsFunc(pid, pipelineID, opts, options...)
+}
+
+func (m *mockJobsService) ListProjectJobs(pid interface{}, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockJobsService) PlayJob(pid interface{}, jobID int, opt *gitlab.PlayJobOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error) {
+	return m.playFunc(pid, jobID, opt, options...)
+}
+
+func (m *mockJobsService) RetryJob(pid interface{}, jobID int, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestListManualJobs(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return &gitlab.Client{
+			Jobs: &mockJobsService{
+				listPipelineJobsFunc: func(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+					assert.Equal(t, "group/project", pid)
+					assert.Equal(t, 42, pipelineID)
+					assert.Equal(t, &[]gitlab.BuildStateValue{gitlab.Manual}, opts.Scope)
+					job := &gitlab.Job{ID: 7, Name: "deploy:production", Stage: "deploy", Status: "manual", Ref: "main", WebURL: "https://gitlab.example.com/group/project/-/jobs/7"}
+					job.Pipeline.ID = pipelineID
+					return []*gitlab.Job{job}, ok, nil
+				},
+			},
+		}, nil
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := ListManualJobs(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"namespace":   "group",
+		"project":     "project",
+		"pipeline_id": float64(42),
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var got manualJobs
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.Equal(t, manualJobs{Jobs: []jobSummary{{
+		ID: 7, Name: "deploy:production", Stage: "deploy", Status: "manual", Ref: "main", PipelineID: 42, WebURL: "https://gitlab.example.com/group/project/-/jobs/7",
+	}}}, got)
+}
+
+func TestPlayJob(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name              string
+		args              map[string]interface{}
+		expectedVariables *[]*gitlab.JobVariableOptions
+		expectedError     string
+	}{
+		{
+			name: "play without variables",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"job_id":    float64(7),
+			},
+		},
+		{
+			name: "play with variables",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"job_id":    float64(7),
+				"variables": map[string]interface{}{"VERSION": "1.2.3"},
+			},
+			expectedVariables: &[]*gitlab.JobVariableOptions{{Key: gitlab.Ptr("VERSION"), Value: gitlab.Ptr("1.2.3")}},
+		},
+		{
+			name: "non-string variable",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"job_id":    float64(7),
+				"variables": map[string]interface{}{"DRY_RUN": true},
+			},
+			expectedError: "variable DRY_RUN must be a string, got bool",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Jobs: &mockJobsService{
+						playFunc: func(pid interface{}, jobID int, opts *gitlab.PlayJobOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Job, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, 7, jobID)
+							assert.Equal(t, tc.expectedVariables, opts.JobVariablesAttributes)
+							return &gitlab.Job{ID: jobID, Name: "deploy:production", Status: "pending"}, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := PlayJob(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got jobSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, jobSummary{ID: 7, Name: "deploy:production", Status: "pending"}, got)
+		})
+	}
+}
@@ -0,0 +1,601 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// labelScope returns the scope of a scoped label, e.g. "priority" for "priority::high".
+// GitLab uses the last "::" as separator, so "team::a::b" belongs to scope "team::a".
+// Unscoped labels return an empty scope.
+func labelScope(label string) string {
+	i := strings.LastIndex(label, "::")
+	if i <= 0 {
+		return ""
+	}
+	return label[:i]
+}
+
+// labelChanges is the result of setting the labels of an issue or merge request
+type labelChanges struct {
+	Labels  []string `json:"labels"`
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
+
+// applyLabelChanges computes the new labels of an issue or merge request. When replace is not nil it
+// becomes the new label set. Adding a scoped label removes any other label of the same scope, so
+// adding "priority::high" to an issue labelled "priority::low" replaces it.
+func applyLabelChanges(current []string, replace []string, add []string, remove []string) labelChanges {
+	labels := slices.Clone(current)
+	if replace != nil {
+		labels = nil
+		add = append(slices.Clone(replace), add...)
+	}
+
+	for _, label := range add {
+		scope := labelScope(label)
+		labels = slices.DeleteFunc(labels, func(l string) bool {
+			return l == label || (scope != "" && labelScope(l) == scope)
+		})
+		labels = append(labels, label)
+	}
+	labels = slices.DeleteFunc(labels, func(l string) bool {
+		return slices.Contains(remove, l)
+	})
+
+	changes := labelChanges{Labels: labels, Added: []string{}, Removed: []string{}}
+	if changes.Labels == nil {
+		changes.Labels = []string{}
+	}
+	for _, label := range labels {
+		if !slices.Contains(current, label) {
+			changes.Added = append(changes.Added, label)
+		}
+	}
+	for _, label := range current {
+		if !slices.Contains(labels, label) {
+			changes.Removed = append(changes.Removed, label)
+		}
+	}
+	return changes
+}
+
+// labelOptions converts labels to the options sent to GitLab, leaving out an empty list
+func labelOptions(labels []string) *gitlab.LabelOptions {
+	if len(labels) == 0 {
+		return nil
+	}
+	return (*gitlab.LabelOptions)(&labels)
+}
+
+// labelsOrEmpty returns the labels GitLab reports after an update, as an empty list rather than null
+func labelsOrEmpty(labels gitlab.Labels) []string {
+	if labels == nil {
+		return []string{}
+	}
+	return labels
+}
+
+// withLabelChanges adds the parameters shared by the tools setting labels
+func withLabelChanges(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithArray("labels",
+			mcp.Description(t("PARAM_SET_LABELS_DESCRIPTION", "Replace all labels with these labels")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		)(tool)
+		mcp.WithArray("add_labels",
+			mcp.Description(t("PARAM_ADD_LABELS_DESCRIPTION", "Labels to add. A scoped label such as priority::high replaces other labels of the same scope")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		)(tool)
+		mcp.WithArray("remove_labels",
+			mcp.Description(t("PARAM_REMOVE_LABELS_DESCRIPTION", "Labels to remove")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		)(tool)
+	}
+}
+
+// labelChangeParams reads the parameters added by withLabelChanges
+func labelChangeParams(r mcp.CallToolRequest) (replace []string, add []string, remove []string, err error) {
+	if replace, err = OptionalStringArrayParam(r, "labels"); err != nil {
+		return nil, nil, nil, err
+	}
+	if add, err = OptionalStringArrayParam(r, "add_labels"); err != nil {
+		return nil, nil, nil, err
+	}
+	if remove, err = OptionalStringArrayParam(r, "remove_labels"); err != nil {
+		return nil, nil, nil, err
+	}
+	if replace == nil && len(add) == 0 && len(remove) == 0 {
+		return nil, nil, nil, fmt.Errorf("one of labels, add_labels or remove_labels is required")
+	}
+	return replace, add, remove, nil
+}
+
+// ListLabels returns a tool for listing the labels of a project or group
+func ListLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_labels",
+		mcp.WithDescription(t("TOOL_LIST_LABELS_DESCRIPTION", "List the labels of a project or group with open issue, closed issue and open merge request counts")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_LABELS_PROJECT_DESCRIPTION", "The name of the project. Omit to use the labels of the namespace group")),
+		),
+		mcp.WithString("search",
+			mcp.Description(t("PARAM_LABELS_SEARCH_DESCRIPTION", "Only return labels containing this text")),
+		),
+		mcp.WithBoolean("include_ancestor_groups",
+			mcp.Description(t("PARAM_INCLUDE_ANCESTOR_GROUPS_DESCRIPTION", "Include labels inherited from ancestor groups. Defaults to true")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := OptionalParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		search, err := OptionalParam[string](r, "search")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		// GitLab includes ancestor group labels unless told otherwise, so the flag is only sent when given
+		includeAncestors, err := optionalPtrParam[bool](r, "include_ancestor_groups")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var searchOpt *string
+		if search != "" {
+			searchOpt = &search
+		}
+
+		var labels []*gitlab.Label
+		if project != "" {
+			labels, _, err = client.Labels.ListLabels(fmt.Sprintf("%s/%s", namespace, project), &gitlab.ListLabelsOptions{
+				ListOptions:           pagination,
+				WithCounts:            gitlab.Ptr(true),
+				IncludeAncestorGroups: includeAncestors,
+				Search:                searchOpt,
+			})
+		} else {
+			var groupLabels []*gitlab.GroupLabel
+			groupLabels, _, err = client.GroupLabels.ListGroupLabels(namespace, &gitlab.ListGroupLabelsOptions{
+				ListOptions:           pagination,
+				WithCounts:            gitlab.Ptr(true),
+				IncludeAncestorGroups: includeAncestors,
+				Search:                searchOpt,
+			})
+			for _, label := range groupLabels {
+				labels = append(labels, (*gitlab.Label)(label))
+			}
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list labels: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(labels)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// CreateLabel returns a tool for creating a project or group label
+func CreateLabel(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"create_label",
+		mcp.WithDescription(t("TOOL_CREATE_LABEL_DESCRIPTION", "Create a project or group label")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_LABELS_PROJECT_DESCRIPTION", "The name of the project. Omit to use the labels of the namespace group")),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_LABEL_NAME_DESCRIPTION", "The name of the label, e.g. bug or priority::high")),
+		),
+		mcp.WithString("color",
+			mcp.Required(),
+			mcp.Description(t("PARAM_LABEL_COLOR_DESCRIPTION", "The color of the label as #RRGGBB or a CSS color name")),
+		),
+		mcp.WithString("description",
+			mcp.Description(t("PARAM_LABEL_DESCRIPTION_DESCRIPTION", "The description of the label")),
+		),
+		mcp.WithNumber("priority",
+			mcp.Description(t("PARAM_LABEL_PRIORITY_DESCRIPTION", "The priority of the label, lower is more important")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := OptionalParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		name, err := requiredParam[string](r, "name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		color, err := requiredParam[string](r, "color")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		description, err := OptionalParam[string](r, "description")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.CreateLabelOptions{Name: &name, Color: &color}
+		if description != "" {
+			opts.Description = &description
+		}
+		if _, ok := r.Params.Arguments["priority"]; ok {
+			priority, err := OptionalInt(r, "priority")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Priority = &priority
+		}
+
+		var label *gitlab.Label
+		if project != "" {
+			label, _, err = client.Labels.CreateLabel(fmt.Sprintf("%s/%s", namespace, project), opts)
+		} else {
+			var groupLabel *gitlab.GroupLabel
+			groupLabel, _, err = client.GroupLabels.CreateGroupLabel(namespace, (*gitlab.CreateGroupLabelOptions)(opts))
+			label = (*gitlab.Label)(groupLabel)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to create label: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(label)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// UpdateLabel returns a tool for updating a project or group label
+func UpdateLabel(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"update_label",
+		mcp.WithDescription(t("TOOL_UPDATE_LABEL_DESCRIPTION", "Rename a project or group label or change its color, description or priority")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_LABELS_PROJECT_DESCRIPTION", "The name of the project. Omit to use the labels of the namespace group")),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_LABEL_CURRENT_NAME_DESCRIPTION", "The current name of the label")),
+		),
+		mcp.WithString("new_name",
+			mcp.Description(t("PARAM_LABEL_NEW_NAME_DESCRIPTION", "The new name of the label")),
+		),
+		mcp.WithString("color",
+			mcp.Description(t("PARAM_LABEL_COLOR_DESCRIPTION", "The color of the label as #RRGGBB or a CSS color name")),
+		),
+		mcp.WithString("description",
+			mcp.Description(t("PARAM_LABEL_DESCRIPTION_DESCRIPTION", "The description of the label")),
+		),
+		mcp.WithNumber("priority",
+			mcp.Description(t("PARAM_LABEL_PRIORITY_DESCRIPTION", "The priority of the label, lower is more important")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := OptionalParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		name, err := requiredParam[string](r, "name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		newName, err := OptionalParam[string](r, "new_name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		color, err := OptionalParam[string](r, "color")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		description, err := optionalPtrParam[string](r, "description")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.UpdateLabelOptions{Description: description}
+		if newName != "" {
+			opts.NewName = &newName
+		}
+		if color != "" {
+			opts.Color = &color
+		}
+		if _, ok := r.Params.Arguments["priority"]; ok {
+			priority, err := OptionalInt(r, "priority")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Priority = &priority
+		}
+
+		var label *gitlab.Label
+		if project != "" {
+			label, _, err = client.Labels.UpdateLabel(fmt.Sprintf("%s/%s", namespace, project), name, opts)
+		} else {
+			var groupLabel *gitlab.GroupLabel
+			groupLabel, _, err = client.GroupLabels.UpdateGroupLabel(namespace, name, (*gitlab.UpdateGroupLabelOptions)(opts))
+			label = (*gitlab.Label)(groupLabel)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to update label: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(label)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// DeleteLabel returns a tool for deleting a project or group label
+func DeleteLabel(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"delete_label",
+		mcp.WithDescription(t("TOOL_DELETE_LABEL_DESCRIPTION", "Delete a project or group label, removing it from all issues and merge requests")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_LABELS_PROJECT_DESCRIPTION", "The name of the project. Omit to use the labels of the namespace group")),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_LABEL_CURRENT_NAME_DESCRIPTION", "The current name of the label")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := OptionalParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		name, err := requiredParam[string](r, "name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if project != "" {
+			_, err = client.Labels.DeleteLabel(fmt.Sprintf("%s/%s", namespace, project), name, nil)
+		} else {
+			_, err = client.GroupLabels.DeleteGroupLabel(namespace, name, nil)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to delete label: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Label %s deleted", name)), nil
+	}
+
+	return tool, handler
+}
+
+// SetIssueLabels returns a tool for replacing, adding or removing the labels of an issue
+func SetIssueLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"set_issue_labels",
+		mcp.WithDescription(t("TOOL_SET_ISSUE_LABELS_DESCRIPTION", "Replace, add or remove the labels of an issue. Scoped labels replace other labels of the same scope")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_ISSUE_ID_DESCRIPTION", "The ID of the issue")),
+		),
+		withLabelChanges(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := RequiredInt(r, "id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		replace, add, remove, err := labelChangeParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		projectID := fmt.Sprintf("%s/%s", namespace, project)
+
+		issue, _, err := client.Issues.GetIssue(projectID, id)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get issue: %w", err).Error()), nil
+		}
+
+		changes := applyLabelChanges(issue.Labels, replace, add, remove)
+		if len(changes.Added) > 0 || len(changes.Removed) > 0 {
+			// only the difference is sent, so labels changed by someone else in the meantime are kept
+			issue, _, err = client.Issues.UpdateIssue(projectID, id, &gitlab.UpdateIssueOptions{
+				AddLabels:    labelOptions(changes.Added),
+				RemoveLabels: labelOptions(changes.Removed),
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to update issue labels: %w", err).Error()), nil
+			}
+			changes.Labels = labelsOrEmpty(issue.Labels)
+		}
+
+		jsonData, err := json.Marshal(changes)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// SetMergeRequestLabels returns a tool for replacing, adding or removing the labels of a merge request
+func SetMergeRequestLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"set_merge_request_labels",
+		mcp.WithDescription(t("TOOL_SET_MERGE_REQUEST_LABELS_DESCRIPTION", "Replace, add or remove the labels of a merge request. Scoped labels replace other labels of the same scope")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_MERGE_REQUEST_ID_DESCRIPTION", "The ID of the merge request")),
+		),
+		withLabelChanges(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := RequiredInt(r, "id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		replace, add, remove, err := labelChangeParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		projectID := fmt.Sprintf("%s/%s", namespace, project)
+
+		mr, _, err := client.MergeRequests.GetMergeRequest(projectID, id, nil)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get merge request: %w", err).Error()), nil
+		}
+
+		changes := applyLabelChanges(mr.Labels, replace, add, remove)
+		if len(changes.Added) > 0 || len(changes.Removed) > 0 {
+			// only the difference is sent, so labels changed by someone else in the meantime are kept
+			mr, _, err = client.MergeRequests.UpdateMergeRequest(projectID, id, &gitlab.UpdateMergeRequestOptions{
+				AddLabels:    labelOptions(changes.Added),
+				RemoveLabels: labelOptions(changes.Removed),
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to update merge request labels: %w", err).Error()), nil
+			}
+			changes.Labels = labelsOrEmpty(mr.Labels)
+		}
+
+		jsonData, err := json.Marshal(changes)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,321 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestLabelScope(t *testing.T) {
+	assert.Equal(t, "priority", labelScope("priority::high"))
+	assert.Equal(t, "team::backend", labelScope("team::backend::api"))
+	assert.Equal(t, "", labelScope("bug"))
+	assert.Equal(t, "", labelScope("::odd"))
+}
+
+func TestApplyLabelChanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  []string
+		replace  []string
+		add      []string
+		remove   []string
+		expected labelChanges
+	}{
+		{
+			name:    "scoped label replaces the same scope",
+			current: []string{"bug", "priority::low"},
+			add:     []string{"priority::high"},
+			expected: labelChanges{
+				Labels:  []string{"bug", "priority::high"},
+				Added:   []string{"priority::high"},
+				Removed: []string{"priority::low"},
+			},
+		},
+		{
+			name:    "nested scopes are distinct",
+			current: []string{"team::backend::api"},
+			add:     []string{"team::frontend"},
+			expected: labelChanges{
+				Labels:  []string{"team::backend::api", "team::frontend"},
+				Added:   []string{"team::frontend"},
+				Removed: []string{},
+			},
+		},
+		{
+			name:    "add and remove",
+			current: []string{"bug", "needs-triage"},
+			add:     []string{"bug", "backend"},
+			remove:  []string{"needs-triage"},
+			expected: labelChanges{
+				Labels:  []string{"bug", "backend"},
+				Added:   []string{"backend"},
+				Removed: []string{"needs-triage"},
+			},
+		},
+		{
+			name:    "replace keeps only the last label of a scope",
+			current: []string{"bug"},
+			replace: []string{"priority::low", "priority::high", "feature"},
+			expected: labelChanges{
+				Labels:  []string{"priority::high", "feature"},
+				Added:   []string{"priority::high", "feature"},
+				Removed: []string{"bug"},
+			},
+		},
+		{
+			name:    "replace with nothing clears labels",
+			current: []string{"bug"},
+			replace: []string{},
+			expected: labelChanges{
+				Labels:  []string{},
+				Added:   []string{},
+				Removed: []string{"bug"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, applyLabelChanges(tc.current, tc.replace, tc.add, tc.remove))
+		})
+	}
+}
+
+func TestListLabels(t *testing.T) {
+	tests := []struct {
+		name             string
+		args             map[string]interface{}
+		expectedPath     string
+		expectedAncestor []string
+	}{
+		{
+			name: "project labels include ancestors by default",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+			},
+			expectedPath: "/api/v4/projects/group/project/labels",
+		},
+		{
+			name: "group labels without ancestors",
+			args: map[string]interface{}{
+				"namespace":               "group",
+				"include_ancestor_groups": false,
+			},
+			expectedPath:     "/api/v4/groups/group/labels",
+			expectedAncestor: []string{"false"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.expectedPath, r.URL.Path)
+				assert.Equal(t, tc.expectedAncestor, r.URL.Query()["include_ancestor_groups"])
+				assert.Equal(t, "true", r.URL.Query().Get("with_counts"))
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `[{"id":1,"name":"priority::high","open_issues_count":2}]`)
+			}))
+			defer srv.Close()
+
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListLabels(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
+
+			var got []*gitlab.Label
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			require.Len(t, got, 1)
+			assert.Equal(t, "priority::high", got[0].Name)
+			assert.Equal(t, 2, got[0].OpenIssuesCount)
+		})
+	}
+}
+
+func TestUpdateLabel(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedBody  map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "rename and clear description",
+			args: map[string]interface{}{
+				"namespace":   "group",
+				"project":     "project",
+				"name":        "bug",
+				"new_name":    "type::bug",
+				"description": "",
+			},
+			expectedBody: map[string]interface{}{"new_name": "type::bug", "description": ""},
+		},
+		{
+			name: "color given as a number",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"name":      "bug",
+				"color":     float64(255),
+			},
+			expectedError: "parameter color is not of type string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/api/v4/projects/group/project/labels/bug", r.URL.Path)
+				var body map[string]interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tc.expectedBody, body)
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"id":1,"name":"type::bug"}`)
+			}))
+			defer srv.Close()
+
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := UpdateLabel(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var got gitlab.Label
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, "type::bug", got.Name)
+		})
+	}
+}
+
+func TestSetIssueLabels(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]interface{}
+		expectedAdd    *gitlab.LabelOptions
+		expectedRemove *gitlab.LabelOptions
+		expectedLabels []string
+		expectedError  string
+	}{
+		{
+			name: "scoped label replaced",
+			args: map[string]interface{}{
+				"namespace":  "test-namespace",
+				"project":    "test-project",
+				"id":         float64(1),
+				"add_labels": []interface{}{"priority::high"},
+			},
+			expectedAdd:    &gitlab.LabelOptions{"priority::high"},
+			expectedRemove: &gitlab.LabelOptions{"priority::low"},
+			expectedLabels: []string{"bug", "priority::high"},
+		},
+		{
+			name: "labels replaced",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"id":        float64(1),
+				"labels":    []interface{}{"bug", "backend"},
+			},
+			expectedAdd:    &gitlab.LabelOptions{"backend"},
+			expectedRemove: &gitlab.LabelOptions{"priority::low"},
+			expectedLabels: []string{"bug", "backend"},
+		},
+		{
+			name: "no change skips the update",
+			args: map[string]interface{}{
+				"namespace":  "test-namespace",
+				"project":    "test-project",
+				"id":         float64(1),
+				"add_labels": []interface{}{"bug"},
+			},
+			expectedLabels: []string{"priority::low", "bug"},
+		},
+		{
+			name: "no label parameters",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"id":        float64(1),
+			},
+			expectedError: "one of labels, add_labels or remove_labels is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var added, removed *gitlab.LabelOptions
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Issues: &mockIssuesService{
+						getFunc: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+							assert.Equal(t, "test-namespace/test-project", pid)
+							return &gitlab.Issue{IID: issue, Labels: gitlab.Labels{"bug", "priority::low"}}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+						updateFunc: func(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+							assert.Nil(t, opt.Labels, "the full label set must not be sent")
+							added, removed = opt.AddLabels, opt.RemoveLabels
+							return &gitlab.Issue{IID: issue, Labels: gitlab.Labels(tc.expectedLabels)}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := SetIssueLabels(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got labelChanges
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expectedAdd, added)
+			assert.Equal(t, tc.expectedRemove, removed)
+			assert.Equal(t, tc.expectedLabels, got.Labels)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package gitlab
+
+import (
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// levelTarget is the project, group or instance a tool works on
+type levelTarget struct {
+	// level is project, group or instance
+	level string
+	// path is the project or group path, empty for the instance
+	path string
+}
+
+// readLevelTarget reads the level, namespace and project parameters of tools working on a project, a group or the instance.
+// The level is inferred from the given paths when it is not set, falling back to the instance only when inferInstance is set.
+// noun names what the tool works on in errors.
+func readLevelTarget(r mcp.CallToolRequest, noun string, inferInstance bool) (levelTarget, error) {
+	var target levelTarget
+	level, err := OptionalParam[string](r, "level")
+	if err != nil {
+		return target, err
+	}
+	namespace, err := OptionalParam[string](r, "namespace")
+	if err != nil {
+		return target, err
+	}
+	project, err := OptionalParam[string](r, "project")
+	if err != nil {
+		return target, err
+	}
+
+	if level == "" {
+		switch {
+		case project != "":
+			level = "project"
+		case namespace != "":
+			level = "group"
+		case inferInstance:
+			level = "instance"
+		default:
+			return target, fmt.Errorf("namespace is required, or level must be set to instance to change instance %s", noun)
+		}
+	}
+	target.level = level
+	switch level {
+	case "project":
+		if namespace == "" || project == "" {
+			return target, fmt.Errorf("namespace and project are required for project %s", noun)
+		}
+		target.path = fmt.Sprintf("%s/%s", namespace, project)
+	case "group":
+		if namespace == "" {
+			return target, fmt.Errorf("namespace is required for group %s", noun)
+		}
+		target.path = namespace
+	case "instance":
+	default:
+		return target, fmt.Errorf("parameter level must be project, group or instance, got %s", level)
+	}
+	return target, nil
+}
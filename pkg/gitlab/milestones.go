@@ -0,0 +1,691 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+const (
+	// maxBurndownDays bounds the length of a burndown, for milestones without sensible dates
+	maxBurndownDays = 366
+
+	// unassignedLabel groups issues and merge requests without an assignee
+	unassignedLabel = "(unassigned)"
+)
+
+// groupMilestoneAsMilestone converts a group milestone so project and group milestones share one response shape
+func groupMilestoneAsMilestone(m *gitlab.GroupMilestone) *gitlab.Milestone {
+	if m == nil {
+		return nil
+	}
+	return &gitlab.Milestone{
+		ID:          m.ID,
+		IID:         m.IID,
+		GroupID:     m.GroupID,
+		Title:       m.Title,
+		Description: m.Description,
+		StartDate:   m.StartDate,
+		DueDate:     m.DueDate,
+		State:       m.State,
+		UpdatedAt:   m.UpdatedAt,
+		CreatedAt:   m.CreatedAt,
+		Expired:     m.Expired,
+	}
+}
+
+// optionalISODate parses an optional date parameter into the date type used by the milestone options
+func optionalISODate(r mcp.CallToolRequest, p string) (*gitlab.ISOTime, error) {
+	v, err := OptionalTime(r, p)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	date := gitlab.ISOTime(*v)
+	return &date, nil
+}
+
+// milestoneIssueStats summarizes the issues of a milestone
+type milestoneIssueStats struct {
+	Total        int `json:"total"`
+	Open         int `json:"open"`
+	Closed       int `json:"closed"`
+	TotalWeight  int `json:"total_weight"`
+	OpenWeight   int `json:"open_weight"`
+	ClosedWeight int `json:"closed_weight"`
+}
+
+// milestoneAssigneeStats summarizes the work of a single assignee in a milestone
+type milestoneAssigneeStats struct {
+	Assignee            string `json:"assignee"`
+	OpenIssues          int    `json:"open_issues"`
+	ClosedIssues        int    `json:"closed_issues"`
+	OpenWeight          int    `json:"open_weight"`
+	ClosedWeight        int    `json:"closed_weight"`
+	OpenMergeRequests   int    `json:"open_merge_requests"`
+	MergedMergeRequests int    `json:"merged_merge_requests"`
+	ClosedMergeRequests int    `json:"closed_merge_requests"`
+}
+
+// burndownDay is the state of a milestone's issues at the end of a day
+type burndownDay struct {
+	Date         string `json:"date"`
+	OpenIssues   int    `json:"open_issues"`
+	OpenWeight   int    `json:"open_weight"`
+	ClosedIssues int    `json:"closed_issues"`
+	ClosedWeight int    `json:"closed_weight"`
+}
+
+// milestoneReport is the response of the get_milestone_report tool
+type milestoneReport struct {
+	Milestone     *gitlab.Milestone         `json:"milestone"`
+	Issues        milestoneIssueStats       `json:"issues"`
+	MergeRequests map[string]int            `json:"merge_requests"`
+	Assignees     []*milestoneAssigneeStats `json:"assignees"`
+	Burndown      []burndownDay             `json:"burndown"`
+	// Truncated lists what had more pages than listAllPages reads, such as the issues or the state events of an issue
+	Truncated []string `json:"truncated,omitempty"`
+}
+
+// aggregateMilestone summarizes issues and merge requests by state, weight and assignee.
+// Work with several assignees counts towards each of them.
+func aggregateMilestone(issues []*gitlab.Issue, mrs []*gitlab.BasicMergeRequest) (milestoneIssueStats, map[string]int, []*milestoneAssigneeStats) {
+	var stats milestoneIssueStats
+	mrStates := make(map[string]int)
+	byAssignee := make(map[string]*milestoneAssigneeStats)
+	assignee := func(username string) *milestoneAssigneeStats {
+		if _, ok := byAssignee[username]; !ok {
+			byAssignee[username] = &milestoneAssigneeStats{Assignee: username}
+		}
+		return byAssignee[username]
+	}
+
+	for _, issue := range issues {
+		closed := issue.State == "closed"
+		stats.Total++
+		stats.TotalWeight += issue.Weight
+		if closed {
+			stats.Closed++
+			stats.ClosedWeight += issue.Weight
+		} else {
+			stats.Open++
+			stats.OpenWeight += issue.Weight
+		}
+
+		usernames := []string{unassignedLabel}
+		if len(issue.Assignees) > 0 {
+			usernames = usernames[:0]
+			for _, a := range issue.Assignees {
+				usernames = append(usernames, a.Username)
+			}
+		}
+		for _, username := range usernames {
+			a := assignee(username)
+			if closed {
+				a.ClosedIssues++
+				a.ClosedWeight += issue.Weight
+			} else {
+				a.OpenIssues++
+				a.OpenWeight += issue.Weight
+			}
+		}
+	}
+
+	for _, mr := range mrs {
+		mrStates[mr.State]++
+
+		usernames := []string{unassignedLabel}
+		if len(mr.Assignees) > 0 {
+			usernames = usernames[:0]
+			for _, a := range mr.Assignees {
+				usernames = append(usernames, a.Username)
+			}
+		}
+		for _, username := range usernames {
+			a := assignee(username)
+			switch mr.State {
+			case "merged":
+				a.MergedMergeRequests++
+			case "closed":
+				a.ClosedMergeRequests++
+			default:
+				a.OpenMergeRequests++
+			}
+		}
+	}
+
+	assignees := make([]*milestoneAssigneeStats, 0, len(byAssignee))
+	for _, a := range byAssignee {
+		assignees = append(assignees, a)
+	}
+	sort.Slice(assignees, func(i, j int) bool {
+		return assignees[i].Assignee < assignees[j].Assignee
+	})
+
+	return stats, mrStates, assignees
+}
+
+// issueClosedBefore reports whether an issue was closed at the given moment by replaying its state events.
+// Issues without state events fall back to their closed_at timestamp.
+func issueClosedBefore(issue *gitlab.Issue, events []*gitlab.StateEvent, moment time.Time) bool {
+	if len(events) == 0 {
+		return issue.ClosedAt != nil && issue.ClosedAt.Before(moment)
+	}
+
+	closed := false
+	for _, event := range events {
+		if event.CreatedAt == nil || !event.CreatedAt.Before(moment) {
+			continue
+		}
+		switch event.State {
+		case gitlab.ClosedEventType:
+			closed = true
+		case gitlab.ReopenedEventType:
+			closed = false
+		}
+	}
+	return closed
+}
+
+// issueInMilestoneBefore reports whether an issue was in the milestone at the given moment by replaying its milestone events.
+// Issues without milestone events fall back to their creation, as if they were created in the milestone.
+func issueInMilestoneBefore(issue *gitlab.Issue, events []*gitlab.MilestoneEvent, milestoneID int, moment time.Time) bool {
+	if len(events) == 0 {
+		return issue.CreatedAt == nil || issue.CreatedAt.Before(moment)
+	}
+
+	in := false
+	for _, event := range events {
+		if event.CreatedAt == nil || !event.CreatedAt.Before(moment) {
+			continue
+		}
+		// an issue has a single milestone, so adding another one takes it out of this one
+		in = event.Action == "add" && event.Milestone != nil && event.Milestone.ID == milestoneID
+	}
+	return in
+}
+
+// issueEvents are the resource events of an issue that the burndown replays
+type issueEvents struct {
+	states     []*gitlab.StateEvent
+	milestones []*gitlab.MilestoneEvent
+}
+
+// milestoneBurndown computes the open and closed issues and weight at the end of every day from start to end.
+// Issues only count from the day they were added to the milestone. Events are keyed by issue ID.
+func milestoneBurndown(start time.Time, end time.Time, milestoneID int, issues []*gitlab.Issue, events map[int]issueEvents) []burndownDay {
+	days := []burndownDay{}
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	for !day.After(end) && len(days) < maxBurndownDays {
+		endOfDay := day.AddDate(0, 0, 1)
+		point := burndownDay{Date: day.Format(time.DateOnly)}
+
+		for _, issue := range issues {
+			if !issueInMilestoneBefore(issue, events[issue.ID].milestones, milestoneID, endOfDay) {
+				continue
+			}
+			if issueClosedBefore(issue, events[issue.ID].states, endOfDay) {
+				point.ClosedIssues++
+				point.ClosedWeight += issue.Weight
+			} else {
+				point.OpenIssues++
+				point.OpenWeight += issue.Weight
+			}
+		}
+
+		days = append(days, point)
+		day = endOfDay
+	}
+
+	return days
+}
+
+// burndownRange returns the days a milestone's burndown covers: from its start date (or creation)
+// until its due date or today, whichever comes first.
+func burndownRange(milestone *gitlab.Milestone, now time.Time) (time.Time, time.Time) {
+	start := now
+	switch {
+	case milestone.StartDate != nil:
+		start = time.Time(*milestone.StartDate)
+	case milestone.CreatedAt != nil:
+		start = *milestone.CreatedAt
+	}
+
+	end := now
+	if milestone.DueDate != nil {
+		if due := time.Time(*milestone.DueDate); due.Before(now) {
+			end = due
+		}
+	}
+
+	return start.UTC(), end.UTC()
+}
+
+// milestoneTarget reads the namespace and optional project shared by the milestone tools.
+// Without a project, the milestone tools work on group milestones of the namespace.
+func milestoneTarget(r mcp.CallToolRequest) (namespace string, project string, err error) {
+	if namespace, err = requiredParam[string](r, "namespace"); err != nil {
+		return "", "", err
+	}
+	if project, err = OptionalParam[string](r, "project"); err != nil {
+		return "", "", err
+	}
+	return namespace, project, nil
+}
+
+// withMilestoneTarget adds the namespace and optional project parameters of the milestone tools
+func withMilestoneTarget(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		)(tool)
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_MILESTONE_PROJECT_DESCRIPTION", "The name of the project. Omit to use the milestones of the namespace group")),
+		)(tool)
+	}
+}
+
+// ListMilestones returns a tool for listing the milestones of a project or group
+func ListMilestones(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_milestones",
+		mcp.WithDescription(t("TOOL_LIST_MILESTONES_DESCRIPTION", "List the milestones of a project or group")),
+		withMilestoneTarget(t),
+		mcp.WithString("state",
+			mcp.Description(t("PARAM_MILESTONE_STATE_DESCRIPTION", "Only return active or closed milestones")),
+			mcp.Enum("active", "closed"),
+		),
+		mcp.WithString("search",
+			mcp.Description(t("PARAM_MILESTONE_SEARCH_DESCRIPTION", "Only return milestones with a title or description containing this text")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, project, err := milestoneTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		stateValue, err := OptionalParam[string](r, "state")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		searchValue, err := OptionalParam[string](r, "search")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var state, search *string
+		if stateValue != "" {
+			state = &stateValue
+		}
+		if searchValue != "" {
+			search = &searchValue
+		}
+
+		var milestones []*gitlab.Milestone
+		if project != "" {
+			milestones, _, err = client.Milestones.ListMilestones(fmt.Sprintf("%s/%s", namespace, project), &gitlab.ListMilestonesOptions{
+				ListOptions: pagination,
+				State:       state,
+				Search:      search,
+			})
+		} else {
+			var groupMilestones []*gitlab.GroupMilestone
+			groupMilestones, _, err = client.GroupMilestones.ListGroupMilestones(namespace, &gitlab.ListGroupMilestonesOptions{
+				ListOptions: pagination,
+				State:       state,
+				Search:      search,
+			})
+			for _, m := range groupMilestones {
+				milestones = append(milestones, groupMilestoneAsMilestone(m))
+			}
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list milestones: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(milestones)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// getMilestone gets a project milestone, or a group milestone when project is empty
+func getMilestone(client *gitlab.Client, namespace string, project string, id int) (*gitlab.Milestone, error) {
+	if project != "" {
+		milestone, _, err := client.Milestones.GetMilestone(fmt.Sprintf("%s/%s", namespace, project), id)
+		return milestone, err
+	}
+	milestone, _, err := client.GroupMilestones.GetGroupMilestone(namespace, id)
+	return groupMilestoneAsMilestone(milestone), err
+}
+
+// GetMilestone returns a tool for getting a single project or group milestone
+func GetMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_milestone",
+		mcp.WithDescription(t("TOOL_GET_MILESTONE_DESCRIPTION", "Get a project or group milestone")),
+		withMilestoneTarget(t),
+		mcp.WithNumber("milestone_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_MILESTONE_ID_DESCRIPTION", "The ID of the milestone")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, project, err := milestoneTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := RequiredInt(r, "milestone_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		milestone, err := getMilestone(client, namespace, project, id)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get milestone: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(milestone)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// CreateMilestone returns a tool for creating a project or group milestone
+func CreateMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"create_milestone",
+		mcp.WithDescription(t("TOOL_CREATE_MILESTONE_DESCRIPTION", "Create a project or group milestone")),
+		withMilestoneTarget(t),
+		mcp.WithString("title",
+			mcp.Required(),
+			mcp.Description(t("PARAM_MILESTONE_TITLE_DESCRIPTION", "The title of the milestone")),
+		),
+		mcp.WithString("description",
+			mcp.Description(t("PARAM_MILESTONE_DESCRIPTION_DESCRIPTION", "The description of the milestone")),
+		),
+		mcp.WithString("start_date",
+			mcp.Description(t("PARAM_MILESTONE_START_DATE_DESCRIPTION", "The start date of the milestone (YYYY-MM-DD)")),
+		),
+		mcp.WithString("due_date",
+			mcp.Description(t("PARAM_MILESTONE_DUE_DATE_DESCRIPTION", "The due date of the milestone (YYYY-MM-DD)")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, project, err := milestoneTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		title, err := requiredParam[string](r, "title")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		startDate, err := optionalISODate(r, "start_date")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		dueDate, err := optionalISODate(r, "due_date")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.CreateMilestoneOptions{
+			Title:     &title,
+			StartDate: startDate,
+			DueDate:   dueDate,
+		}
+		description, err := OptionalParam[string](r, "description")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if description != "" {
+			opts.Description = &description
+		}
+
+		var milestone *gitlab.Milestone
+		if project != "" {
+			milestone, _, err = client.Milestones.CreateMilestone(fmt.Sprintf("%s/%s", namespace, project), opts)
+		} else {
+			var groupMilestone *gitlab.GroupMilestone
+			groupMilestone, _, err = client.GroupMilestones.CreateGroupMilestone(namespace, (*gitlab.CreateGroupMilestoneOptions)(opts))
+			milestone = groupMilestoneAsMilestone(groupMilestone)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to create milestone: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(milestone)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// CloseMilestone returns a tool for closing a project or group milestone
+func CloseMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"close_milestone",
+		mcp.WithDescription(t("TOOL_CLOSE_MILESTONE_DESCRIPTION", "Close a project or group milestone")),
+		withMilestoneTarget(t),
+		mcp.WithNumber("milestone_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_MILESTONE_ID_DESCRIPTION", "The ID of the milestone")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, project, err := milestoneTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := RequiredInt(r, "milestone_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var milestone *gitlab.Milestone
+		if project != "" {
+			milestone, _, err = client.Milestones.UpdateMilestone(fmt.Sprintf("%s/%s", namespace, project), id, &gitlab.UpdateMilestoneOptions{
+				StateEvent: gitlab.Ptr("close"),
+			})
+		} else {
+			var groupMilestone *gitlab.GroupMilestone
+			groupMilestone, _, err = client.GroupMilestones.UpdateGroupMilestone(namespace, id, &gitlab.UpdateGroupMilestoneOptions{
+				StateEvent: gitlab.Ptr("close"),
+			})
+			milestone = groupMilestoneAsMilestone(groupMilestone)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to close milestone: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(milestone)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// milestoneWork loads the issues and merge requests of a project or group milestone.
+// It also returns what had more pages than were read.
+func milestoneWork(client *gitlab.Client, namespace string, project string, id int) ([]*gitlab.Issue, []*gitlab.BasicMergeRequest, []string, error) {
+	var truncated []string
+	pid := fmt.Sprintf("%s/%s", namespace, project)
+
+	issues, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.Issue, *gitlab.Response, error) {
+		if project != "" {
+			return client.Milestones.GetMilestoneIssues(pid, id, (*gitlab.GetMilestoneIssuesOptions)(&opts))
+		}
+		return client.GroupMilestones.GetGroupMilestoneIssues(namespace, id, (*gitlab.GetGroupMilestoneIssuesOptions)(&opts))
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list milestone issues: %w", err)
+	}
+	if morePages(resp) {
+		truncated = append(truncated, "issues")
+	}
+
+	mrs, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		if project != "" {
+			return client.Milestones.GetMilestoneMergeRequests(pid, id, (*gitlab.GetMilestoneMergeRequestsOptions)(&opts))
+		}
+		return client.GroupMilestones.GetGroupMilestoneMergeRequests(namespace, id, (*gitlab.GetGroupMilestoneMergeRequestsOptions)(&opts))
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list milestone merge requests: %w", err)
+	}
+	if morePages(resp) {
+		truncated = append(truncated, "merge_requests")
+	}
+
+	return issues, mrs, truncated, nil
+}
+
+// listIssueEvents loads the state and milestone events of an issue for the burndown.
+// It also returns what had more pages than were read.
+func listIssueEvents(client *gitlab.Client, issue *gitlab.Issue) (issueEvents, []string, error) {
+	var events issueEvents
+	var truncated []string
+
+	states, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.StateEvent, *gitlab.Response, error) {
+		return client.ResourceStateEvents.ListIssueStateEvents(issue.ProjectID, issue.IID, &gitlab.ListStateEventsOptions{ListOptions: opts})
+	})
+	if err != nil {
+		return events, nil, fmt.Errorf("failed to list state events of issue #%d: %w", issue.IID, err)
+	}
+	if morePages(resp) {
+		truncated = append(truncated, fmt.Sprintf("state events of issue #%d", issue.IID))
+	}
+	events.states = states
+
+	milestones, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.MilestoneEvent, *gitlab.Response, error) {
+		return client.ResourceMilestoneEvents.ListIssueMilestoneEvents(issue.ProjectID, issue.IID, &gitlab.ListMilestoneEventsOptions{ListOptions: opts})
+	})
+	if err != nil {
+		return events, nil, fmt.Errorf("failed to list milestone events of issue #%d: %w", issue.IID, err)
+	}
+	if morePages(resp) {
+		truncated = append(truncated, fmt.Sprintf("milestone events of issue #%d", issue.IID))
+	}
+	events.milestones = milestones
+
+	return events, truncated, nil
+}
+
+// GetMilestoneReport returns a tool for summarizing a milestone with a burndown
+func GetMilestoneReport(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_milestone_report",
+		mcp.WithDescription(t("TOOL_GET_MILESTONE_REPORT_DESCRIPTION", "Summarize a milestone's issues and merge requests by state, weight and assignee, with a day-by-day burndown counting each issue from when it was added to the milestone. Reads the state and milestone events of every issue, so large milestones take a while")),
+		withMilestoneTarget(t),
+		mcp.WithNumber("milestone_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_MILESTONE_ID_DESCRIPTION", "The ID of the milestone")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, project, err := milestoneTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := RequiredInt(r, "milestone_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		milestone, err := getMilestone(client, namespace, project, id)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get milestone: %w", err).Error()), nil
+		}
+
+		issues, mrs, truncated, err := milestoneWork(client, namespace, project, id)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// GitLab has no endpoint for the events of many issues at once, so they are read issue by issue
+		events := make(map[int]issueEvents, len(issues))
+		for _, issue := range issues {
+			issueEvents, issueTruncated, err := listIssueEvents(client, issue)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			events[issue.ID] = issueEvents
+			truncated = append(truncated, issueTruncated...)
+		}
+
+		report := milestoneReport{Milestone: milestone, Truncated: truncated}
+		report.Issues, report.MergeRequests, report.Assignees = aggregateMilestone(issues, mrs)
+		start, end := burndownRange(milestone, time.Now())
+		report.Burndown = milestoneBurndown(start, end, milestone.ID, issues, events)
+
+		jsonData, err := json.Marshal(report)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
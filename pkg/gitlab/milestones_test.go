@@ -0,0 +1,447 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestAggregateMilestone(t *testing.T) {
+	issues := []*gitlab.Issue{
+		{ID: 1, State: "opened", Weight: 3, Assignees: []*gitlab.IssueAssignee{{Username: "alice"}}},
+		{ID: 2, State: "closed", Weight: 5, Assignees: []*gitlab.IssueAssignee{{Username: "alice"}, {Username: "bob"}}},
+		{ID: 3, State: "opened", Weight: 1},
+	}
+	mrs := []*gitlab.BasicMergeRequest{
+		{State: "merged", Assignees: []*gitlab.BasicUser{{Username: "bob"}}},
+		{State: "opened", Assignees: []*gitlab.BasicUser{{Username: "bob"}}},
+	}
+
+	stats, mrStates, assignees := aggregateMilestone(issues, mrs)
+
+	assert.Equal(t, milestoneIssueStats{Total: 3, Open: 2, Closed: 1, TotalWeight: 9, OpenWeight: 4, ClosedWeight: 5}, stats)
+	assert.Equal(t, map[string]int{"merged": 1, "opened": 1}, mrStates)
+	assert.Equal(t, []*milestoneAssigneeStats{
+		{Assignee: unassignedLabel, OpenIssues: 1, OpenWeight: 1},
+		{Assignee: "alice", OpenIssues: 1, ClosedIssues: 1, OpenWeight: 3, ClosedWeight: 5},
+		{Assignee: "bob", ClosedIssues: 1, ClosedWeight: 5, OpenMergeRequests: 1, MergedMergeRequests: 1},
+	}, assignees)
+}
+
+func TestMilestoneBurndown(t *testing.T) {
+	at := func(day int, hour int) *time.Time {
+		v := time.Date(2025, 3, day, hour, 0, 0, 0, time.UTC)
+		return &v
+	}
+
+	issues := []*gitlab.Issue{
+		{ID: 1, Weight: 2, CreatedAt: at(1, 9)},
+		{ID: 2, Weight: 3, CreatedAt: at(1, 9), ClosedAt: at(2, 12)},
+		{ID: 3, Weight: 1, CreatedAt: at(3, 9)},
+		{ID: 4, Weight: 4, CreatedAt: at(1, 9)},
+	}
+	events := map[int]issueEvents{
+		1: {states: []*gitlab.StateEvent{
+			{CreatedAt: at(2, 10), State: gitlab.ClosedEventType},
+			{CreatedAt: at(3, 10), State: gitlab.ReopenedEventType},
+		}},
+		// added to the milestone a day after it was created, then moved to another one
+		4: {milestones: []*gitlab.MilestoneEvent{
+			{CreatedAt: at(2, 8), Action: "add", Milestone: &gitlab.Milestone{ID: 7}},
+			{CreatedAt: at(3, 8), Action: "add", Milestone: &gitlab.Milestone{ID: 8}},
+		}},
+	}
+
+	days := milestoneBurndown(*at(1, 0), *at(3, 0), 7, issues, events)
+
+	assert.Equal(t, []burndownDay{
+		{Date: "2025-03-01", OpenIssues: 2, OpenWeight: 5},
+		{Date: "2025-03-02", OpenIssues: 1, OpenWeight: 4, ClosedIssues: 2, ClosedWeight: 5},
+		{Date: "2025-03-03", OpenIssues: 2, OpenWeight: 3, ClosedIssues: 1, ClosedWeight: 3},
+	}, days)
+}
+
+func TestBurndownRange(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	start := gitlab.ISOTime(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	pastDue := gitlab.ISOTime(time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC))
+	futureDue := gitlab.ISOTime(time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC))
+
+	from, to := burndownRange(&gitlab.Milestone{StartDate: &start, DueDate: &pastDue}, now)
+	assert.Equal(t, time.Time(start), from)
+	assert.Equal(t, time.Time(pastDue), to)
+
+	_, to = burndownRange(&gitlab.Milestone{StartDate: &start, DueDate: &futureDue}, now)
+	assert.Equal(t, now, to, "an ongoing milestone ends today")
+}
+
+// newMilestoneServer fakes the milestone endpoints of project group/project and of group group.
+// Milestone 7 exists in both; other milestones are not found.
+func newMilestoneServer(t *testing.T, moreIssues bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v4/projects/group/project/milestones", "/api/v4/groups/group/milestones":
+			if r.Method == http.MethodPost {
+				var opts map[string]interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&opts))
+				fmt.Fprintf(w, `{"id":71,"iid":8,"title":%q,"due_date":%q,"state":"active"}`, opts["title"], opts["due_date"])
+				return
+			}
+			assert.Equal(t, "active", r.URL.Query().Get("state"))
+			fmt.Fprint(w, `[{"id":70,"iid":7,"title":"Sprint 1","state":"active"}]`)
+		case "/api/v4/projects/group/project/milestones/7", "/api/v4/groups/group/milestones/7":
+			if r.Method == http.MethodPut {
+				var opts map[string]interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&opts))
+				assert.Equal(t, "close", opts["state_event"])
+				fmt.Fprint(w, `{"id":70,"iid":7,"title":"Sprint 1","state":"closed"}`)
+				return
+			}
+			fmt.Fprint(w, `{"id":70,"iid":7,"title":"Sprint 1","state":"active","start_date":"2025-03-01","due_date":"2025-03-03"}`)
+		case "/api/v4/projects/group/project/milestones/7/issues", "/api/v4/groups/group/milestones/7/issues":
+			if moreIssues {
+				// the following pages are left empty so only the first one counts
+				w.Header().Set("X-Next-Page", "2")
+				if page := r.URL.Query().Get("page"); page != "" && page != "1" {
+					fmt.Fprint(w, `[]`)
+					return
+				}
+			}
+			fmt.Fprint(w, `[
+				{"id":1,"iid":1,"project_id":6,"state":"closed","weight":3,"assignees":[{"username":"alice"}],"created_at":"2025-02-20T00:00:00Z"},
+				{"id":2,"iid":2,"project_id":6,"state":"opened","weight":2,"created_at":"2025-02-20T00:00:00Z"}
+			]`)
+		case "/api/v4/projects/group/project/milestones/7/merge_requests", "/api/v4/groups/group/milestones/7/merge_requests":
+			fmt.Fprint(w, `[{"id":11,"iid":1,"state":"merged","assignees":[{"username":"bob"}]}]`)
+		case "/api/v4/projects/6/issues/1/resource_state_events":
+			fmt.Fprint(w, `[{"id":1,"state":"closed","created_at":"2025-03-02T10:00:00Z"}]`)
+		case "/api/v4/projects/6/issues/2/resource_state_events", "/api/v4/projects/6/issues/1/resource_milestone_events":
+			fmt.Fprint(w, `[]`)
+		case "/api/v4/projects/6/issues/2/resource_milestone_events":
+			// issue 2 was added to the milestone after it started
+			fmt.Fprint(w, `[{"id":1,"action":"add","milestone":{"id":70},"created_at":"2025-03-02T12:00:00Z"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"404 Not found"}`)
+		}
+	}))
+}
+
+func TestListMilestones(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "project milestones",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"state":     "active",
+			},
+		},
+		{
+			name: "group milestones",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"state":     "active",
+			},
+		},
+		{
+			name: "state of the wrong type",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"state":     float64(1),
+			},
+			expectedError: "parameter state is not of type string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newMilestoneServer(t, false)
+			defer srv.Close()
+
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListMilestones(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var got []*gitlab.Milestone
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			require.Len(t, got, 1)
+			assert.Equal(t, 7, got[0].IID)
+			assert.Equal(t, "Sprint 1", got[0].Title)
+		})
+	}
+}
+
+func TestGetMilestone(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "project milestone",
+			args: map[string]interface{}{
+				"namespace":    "group",
+				"project":      "project",
+				"milestone_id": float64(7),
+			},
+		},
+		{
+			name: "group milestone",
+			args: map[string]interface{}{
+				"namespace":    "group",
+				"milestone_id": float64(7),
+			},
+		},
+		{
+			name: "unknown milestone",
+			args: map[string]interface{}{
+				"namespace":    "group",
+				"project":      "project",
+				"milestone_id": float64(8),
+			},
+			expectedError: "failed to get milestone",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newMilestoneServer(t, false)
+			defer srv.Close()
+
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := GetMilestone(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var got gitlab.Milestone
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, 70, got.ID)
+			assert.Equal(t, "2025-03-03", got.DueDate.String())
+		})
+	}
+}
+
+func TestCreateMilestone(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "project milestone",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"title":     "Sprint 2",
+				"due_date":  "2025-03-17",
+			},
+		},
+		{
+			name: "group milestone",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"title":     "Sprint 2",
+				"due_date":  "2025-03-17",
+			},
+		},
+		{
+			name: "invalid due date",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"title":     "Sprint 2",
+				"due_date":  "17/03/2025",
+			},
+			expectedError: "due_date",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newMilestoneServer(t, false)
+			defer srv.Close()
+
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := CreateMilestone(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var got gitlab.Milestone
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, "Sprint 2", got.Title)
+			assert.Equal(t, "2025-03-17", got.DueDate.String())
+		})
+	}
+}
+
+func TestCloseMilestone(t *testing.T) {
+	for _, args := range []map[string]interface{}{
+		{"namespace": "group", "project": "project", "milestone_id": float64(7)},
+		{"namespace": "group", "milestone_id": float64(7)},
+	} {
+		srv := newMilestoneServer(t, false)
+
+		getClient := func(ctx context.Context) (*gitlab.Client, error) {
+			return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+		}
+
+		translationHelper := func(key string, defaultValue string) string {
+			return defaultValue
+		}
+
+		_, handler := CloseMilestone(getClient, translationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+
+		textContent := getTextResult(t, result)
+		require.False(t, result.IsError, textContent.Text)
+
+		var got gitlab.Milestone
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+		assert.Equal(t, "closed", got.State)
+		srv.Close()
+	}
+}
+
+func TestGetMilestoneReport(t *testing.T) {
+	tests := []struct {
+		name              string
+		args              map[string]interface{}
+		moreIssues        bool
+		expectedTruncated []string
+		expectedError     string
+	}{
+		{
+			name: "project milestone",
+			args: map[string]interface{}{
+				"namespace":    "group",
+				"project":      "project",
+				"milestone_id": float64(7),
+			},
+		},
+		{
+			name: "group milestone with more issues than read",
+			args: map[string]interface{}{
+				"namespace":    "group",
+				"milestone_id": float64(7),
+			},
+			moreIssues:        true,
+			expectedTruncated: []string{"issues"},
+		},
+		{
+			name: "unknown milestone",
+			args: map[string]interface{}{
+				"namespace":    "group",
+				"milestone_id": float64(8),
+			},
+			expectedError: "failed to get milestone",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newMilestoneServer(t, tc.moreIssues)
+			defer srv.Close()
+
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := GetMilestoneReport(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var got milestoneReport
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, milestoneIssueStats{Total: 2, Open: 1, Closed: 1, TotalWeight: 5, OpenWeight: 2, ClosedWeight: 3}, got.Issues)
+			assert.Equal(t, map[string]int{"merged": 1}, got.MergeRequests)
+			assert.Equal(t, []*milestoneAssigneeStats{
+				{Assignee: unassignedLabel, OpenIssues: 1, OpenWeight: 2},
+				{Assignee: "alice", ClosedIssues: 1, ClosedWeight: 3},
+				{Assignee: "bob", MergedMergeRequests: 1},
+			}, got.Assignees)
+			assert.Equal(t, []burndownDay{
+				{Date: "2025-03-01", OpenIssues: 1, OpenWeight: 3},
+				{Date: "2025-03-02", OpenIssues: 1, OpenWeight: 2, ClosedIssues: 1, ClosedWeight: 3},
+				{Date: "2025-03-03", OpenIssues: 1, OpenWeight: 2, ClosedIssues: 1, ClosedWeight: 3},
+			}, got.Burndown)
+			assert.Equal(t, tc.expectedTruncated, got.Truncated)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package gitlab
+
+import (
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// maxListPages bounds how many pages listAllPages reads
+const maxListPages = 10
+
+// defaultPerPage is the page size GitLab uses when per_page is not given
+const defaultPerPage = 20
+
+// withPagination adds the optional page and per_page parameters to a tool
+func withPagination(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithNumber("page",
+			mcp.Description(t("PARAM_PAGE_DESCRIPTION", "Page number for pagination (min 1)")),
+			mcp.Min(1),
+		)(tool)
+		mcp.WithNumber("per_page",
+			mcp.Description(t("PARAM_PER_PAGE_DESCRIPTION", "Results per page for pagination (min 1, max 100)")),
+			mcp.Min(1),
+			mcp.Max(100),
+		)(tool)
+	}
+}
+
+// OptionalPaginationParams returns the page and per_page parameters from the request as gitlab.ListOptions.
+// Missing values are left at zero so that GitLab applies its own defaults.
+func OptionalPaginationParams(r mcp.CallToolRequest) (gitlab.ListOptions, error) {
+	page, err := OptionalInt(r, "page")
+	if err != nil {
+		return gitlab.ListOptions{}, err
+	}
+	perPage, err := OptionalInt(r, "per_page")
+	if err != nil {
+		return gitlab.ListOptions{}, err
+	}
+	return gitlab.ListOptions{
+		Page:    page,
+		PerPage: perPage,
+	}, nil
+}
+
+// listAllPages reads the pages of a list endpoint until the last page or maxListPages.
+// It returns the last response read, so callers can tell with morePages whether the list was cut off.
+func listAllPages[T any](list func(opts gitlab.ListOptions) ([]T, *gitlab.Response, error)) ([]T, *gitlab.Response, error) {
+	var all []T
+	var resp *gitlab.Response
+	opts := gitlab.ListOptions{PerPage: 100}
+	for page := 0; page < maxListPages; page++ {
+		batch, r, err := list(opts)
+		if err != nil {
+			return nil, r, err
+		}
+		all = append(all, batch...)
+		resp = r
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return all, resp, nil
+}
+
+// morePages reports whether a list has pages after the given response, such as when listAllPages stopped at maxListPages
+func morePages(resp *gitlab.Response) bool {
+	return resp != nil && resp.NextPage != 0
+}
+
+// listPage returns one page of items that were filtered or sorted after listAllPages, counting pages from 1
+func listPage[T any](items []T, pagination gitlab.ListOptions) []T {
+	page, perPage := pagination.Page, pagination.PerPage
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+	start := (page - 1) * perPage
+	if start >= len(items) {
+		return []T{}
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end]
+}
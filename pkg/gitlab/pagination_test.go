@@ -0,0 +1,53 @@
+package gitlab
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestListAllPages(t *testing.T) {
+	tests := []struct {
+		name          string
+		pages         int
+		expectedCalls int
+		expectedMore  bool
+	}{
+		{
+			name:          "stops at the last page",
+			pages:         3,
+			expectedCalls: 3,
+		},
+		{
+			name:          "stops at maxListPages and reports more pages",
+			pages:         maxListPages + 2,
+			expectedCalls: maxListPages,
+			expectedMore:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			items, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]int, *gitlab.Response, error) {
+				calls++
+				page := opts.Page
+				if page == 0 {
+					page = 1
+				}
+				resp := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+				if page < tc.pages {
+					resp.NextPage = page + 1
+				}
+				return []int{page}, resp, nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCalls, calls)
+			assert.Len(t, items, tc.expectedCalls)
+			assert.Equal(t, tc.expectedMore, morePages(resp))
+		})
+	}
+}
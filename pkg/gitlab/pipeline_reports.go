@@ -0,0 +1,403 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// defaultMaxTestOutputBytes is the default amount of output kept for each failed test case.
+// The end of the output is kept, as that is where assertion failures usually are.
+const defaultMaxTestOutputBytes = 2000
+
+// defaultCoveragePipelines is how many pipelines get_coverage_trend reads by default
+const defaultCoveragePipelines = 10
+
+// truncatedOutputMarker is prepended to test output that has been cut to fit the budget
+const truncatedOutputMarker = "... output truncated ...\n"
+
+// testSuiteSummary is a test suite without its test cases
+type testSuiteSummary struct {
+	Name         string  `json:"name"`
+	TotalTime    float64 `json:"total_time"`
+	TotalCount   int     `json:"total_count"`
+	SuccessCount int     `json:"success_count"`
+	FailedCount  int     `json:"failed_count"`
+	SkippedCount int     `json:"skipped_count"`
+	ErrorCount   int     `json:"error_count"`
+}
+
+// failedTestCase is a failed or errored test case with its output cut to size
+type failedTestCase struct {
+	Suite         string  `json:"suite"`
+	Name          string  `json:"name"`
+	Classname     string  `json:"classname,omitempty"`
+	File          string  `json:"file,omitempty"`
+	Status        string  `json:"status"`
+	ExecutionTime float64 `json:"execution_time"`
+	Output        string  `json:"output,omitempty"`
+	// RecentFailures is how often the test failed on the base branch recently, when GitLab tracks it
+	RecentFailures int    `json:"recent_failures,omitempty"`
+	BaseBranch     string `json:"base_branch,omitempty"`
+}
+
+// flakyTestCase is a test case that looks flaky, with why
+type flakyTestCase struct {
+	Suite  string `json:"suite"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// testReport is the response of get_pipeline_test_report
+type testReport struct {
+	PipelineID   int                `json:"pipeline_id"`
+	TotalTime    float64            `json:"total_time"`
+	TotalCount   int                `json:"total_count"`
+	SuccessCount int                `json:"success_count"`
+	FailedCount  int                `json:"failed_count"`
+	SkippedCount int                `json:"skipped_count"`
+	ErrorCount   int                `json:"error_count"`
+	Suites       []testSuiteSummary `json:"suites"`
+	Failed       []failedTestCase   `json:"failed"`
+	Flaky        []flakyTestCase    `json:"flaky"`
+}
+
+// testOutput joins the output of a test case, which GitLab returns as a string or a list of strings,
+// and keeps its last maxBytes
+func testOutput(testCase *gitlab.PipelineTestCases, maxBytes int) string {
+	var parts []string
+	switch output := testCase.SystemOutput.(type) {
+	case string:
+		parts = append(parts, output)
+	case []interface{}:
+		for _, line := range output {
+			if s, ok := line.(string); ok {
+				parts = append(parts, s)
+			}
+		}
+	}
+	if testCase.StackTrace != "" {
+		parts = append(parts, testCase.StackTrace)
+	}
+
+	text := strings.TrimSpace(strings.Join(parts, "\n"))
+	if len(text) <= maxBytes {
+		return text
+	}
+	return truncatedOutputMarker + strings.ToValidUTF8(text[len(text)-maxBytes:], "")
+}
+
+// buildTestReport summarizes a pipeline test report. A test case looks flaky when it both passed and
+// failed in the pipeline, which happens when jobs are retried, or when it failed here and also recently
+// failed on the base branch, so the failure is not caused by the change under test.
+func buildTestReport(pipelineID int, report *gitlab.PipelineTestReport, maxOutputBytes int) testReport {
+	result := testReport{
+		PipelineID:   pipelineID,
+		TotalTime:    report.TotalTime,
+		TotalCount:   report.TotalCount,
+		SuccessCount: report.SuccessCount,
+		FailedCount:  report.FailedCount,
+		SkippedCount: report.SkippedCount,
+		ErrorCount:   report.ErrorCount,
+		Suites:       make([]testSuiteSummary, 0, len(report.TestSuites)),
+		Failed:       []failedTestCase{},
+		Flaky:        []flakyTestCase{},
+	}
+
+	for _, suite := range report.TestSuites {
+		result.Suites = append(result.Suites, testSuiteSummary{
+			Name:         suite.Name,
+			TotalTime:    suite.TotalTime,
+			TotalCount:   suite.TotalCount,
+			SuccessCount: suite.SuccessCount,
+			FailedCount:  suite.FailedCount,
+			SkippedCount: suite.SkippedCount,
+			ErrorCount:   suite.ErrorCount,
+		})
+
+		passed := map[string]bool{}
+		for _, testCase := range suite.TestCases {
+			if testCase.Status == "success" {
+				passed[testCase.Classname+"\x00"+testCase.Name] = true
+			}
+		}
+
+		flagged := map[string]bool{}
+		for _, testCase := range suite.TestCases {
+			if testCase.Status != "failed" && testCase.Status != "error" {
+				continue
+			}
+			failed := failedTestCase{
+				Suite:         suite.Name,
+				Name:          testCase.Name,
+				Classname:     testCase.Classname,
+				File:          testCase.File,
+				Status:        testCase.Status,
+				ExecutionTime: testCase.ExecutionTime,
+				Output:        testOutput(testCase, maxOutputBytes),
+			}
+			if testCase.RecentFailures != nil {
+				failed.RecentFailures = testCase.RecentFailures.Count
+				failed.BaseBranch = testCase.RecentFailures.BaseBranch
+			}
+			result.Failed = append(result.Failed, failed)
+
+			key := testCase.Classname + "\x00" + testCase.Name
+			if flagged[key] {
+				continue
+			}
+			switch {
+			case passed[key]:
+				result.Flaky = append(result.Flaky, flakyTestCase{Suite: suite.Name, Name: testCase.Name, Reason: "both passed and failed in this pipeline"})
+				flagged[key] = true
+			case failed.RecentFailures > 0:
+				result.Flaky = append(result.Flaky, flakyTestCase{
+					Suite:  suite.Name,
+					Name:   testCase.Name,
+					Reason: fmt.Sprintf("also failed %d times recently on %s", failed.RecentFailures, failed.BaseBranch),
+				})
+				flagged[key] = true
+			}
+		}
+	}
+
+	return result
+}
+
+// GetPipelineTestReport returns a tool for summarizing the test report of a pipeline
+func GetPipelineTestReport(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_pipeline_test_report",
+		mcp.WithDescription(t("TOOL_GET_PIPELINE_TEST_REPORT_DESCRIPTION", "Get the test report of a pipeline: totals per suite, the failed test cases with the end of their output, and test cases that look flaky")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("pipeline_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PIPELINE_ID_DESCRIPTION", "The ID of the pipeline")),
+		),
+		mcp.WithNumber("max_output_bytes",
+			mcp.Description(t("PARAM_MAX_TEST_OUTPUT_BYTES_DESCRIPTION", fmt.Sprintf("Maximum size of output kept for each failed test case, from its end (default %d)", defaultMaxTestOutputBytes))),
+			mcp.Min(0),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pipelineID, err := RequiredInt(r, "pipeline_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		maxOutputBytes, err := OptionalInt(r, "max_output_bytes")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if maxOutputBytes <= 0 {
+			maxOutputBytes = defaultMaxTestOutputBytes
+		}
+
+		report, _, err := client.Pipelines.GetPipelineTestReport(fmt.Sprintf("%s/%s", namespace, project), pipelineID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get pipeline test report: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(buildTestReport(pipelineID, report, maxOutputBytes))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// coveragePoint is the coverage of a pipeline and of its jobs
+type coveragePoint struct {
+	PipelineID int        `json:"pipeline_id"`
+	SHA        string     `json:"sha"`
+	Status     string     `json:"status"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	// Coverage is the average of the job coverages, as GitLab computes it, or nil when no job reported coverage
+	Coverage *float64           `json:"coverage"`
+	Jobs     map[string]float64 `json:"jobs,omitempty"`
+}
+
+// coverageTrend is the response of get_coverage_trend
+type coverageTrend struct {
+	Ref string `json:"ref"`
+	// Pipelines are ordered from oldest to newest
+	Pipelines []coveragePoint `json:"pipelines"`
+	Latest    *float64        `json:"latest,omitempty"`
+	// Change is the latest coverage minus the oldest coverage in the range
+	Change *float64 `json:"change,omitempty"`
+}
+
+// roundCoverage rounds a coverage percentage to two decimals
+func roundCoverage(coverage float64) float64 {
+	return math.Round(coverage*100) / 100
+}
+
+// coverageOf builds the coverage of a pipeline from its jobs. Jobs without coverage report 0 and are left out,
+// and only jobs named job are counted when it is set.
+func coverageOf(pipeline *gitlab.PipelineInfo, jobs []*gitlab.Job, job string) coveragePoint {
+	point := coveragePoint{
+		PipelineID: pipeline.ID,
+		SHA:        pipeline.SHA,
+		Status:     pipeline.Status,
+		CreatedAt:  pipeline.CreatedAt,
+	}
+	var total float64
+	for _, j := range jobs {
+		if j.Coverage == 0 || (job != "" && j.Name != job) {
+			continue
+		}
+		if point.Jobs == nil {
+			point.Jobs = map[string]float64{}
+		}
+		point.Jobs[j.Name] = j.Coverage
+		total += j.Coverage
+	}
+	if len(point.Jobs) > 0 {
+		point.Coverage = gitlab.Ptr(roundCoverage(total / float64(len(point.Jobs))))
+	}
+	return point
+}
+
+// buildCoverageTrend orders the coverage of pipelines from oldest to newest and computes the change over the range
+func buildCoverageTrend(ref string, points []coveragePoint) coverageTrend {
+	sort.SliceStable(points, func(i, j int) bool { return points[i].PipelineID < points[j].PipelineID })
+	trend := coverageTrend{Ref: ref, Pipelines: points}
+
+	var first *float64
+	for _, point := range points {
+		if point.Coverage == nil {
+			continue
+		}
+		if first == nil {
+			first = point.Coverage
+		}
+		trend.Latest = point.Coverage
+	}
+	if first != nil {
+		trend.Change = gitlab.Ptr(roundCoverage(*trend.Latest - *first))
+	}
+	return trend
+}
+
+// GetCoverageTrend returns a tool for reporting the coverage of the last pipelines of a ref
+func GetCoverageTrend(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_coverage_trend",
+		mcp.WithDescription(t("TOOL_GET_COVERAGE_TREND_DESCRIPTION", "Report the test coverage of each job across the last finished pipelines of a branch or tag, and how the coverage changed over them")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("ref",
+			mcp.Required(),
+			mcp.Description(t("PARAM_COVERAGE_REF_DESCRIPTION", "The branch or tag")),
+		),
+		mcp.WithNumber("pipelines",
+			mcp.Description(t("PARAM_COVERAGE_PIPELINES_DESCRIPTION", fmt.Sprintf("How many of the last finished pipelines to read (default %d)", defaultCoveragePipelines))),
+			mcp.Min(1),
+			mcp.Max(100),
+		),
+		mcp.WithString("job",
+			mcp.Description(t("PARAM_COVERAGE_JOB_DESCRIPTION", "Only count the coverage of the job with this name")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref, err := requiredParam[string](r, "ref")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		count, err := OptionalInt(r, "pipelines")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if count <= 0 {
+			count = defaultCoveragePipelines
+		}
+		job, err := OptionalParam[string](r, "job")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pid := fmt.Sprintf("%s/%s", namespace, project)
+
+		pipelines, _, err := client.Pipelines.ListProjectPipelines(pid, &gitlab.ListProjectPipelinesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: count},
+			Ref:         gitlab.Ptr(ref),
+			Scope:       gitlab.Ptr("finished"),
+			OrderBy:     gitlab.Ptr("id"),
+			Sort:        gitlab.Ptr("desc"),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list pipelines: %w", err).Error()), nil
+		}
+
+		points := make([]coveragePoint, 0, len(pipelines))
+		for _, pipeline := range pipelines {
+			jobs, _, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.Job, *gitlab.Response, error) {
+				return client.Jobs.ListPipelineJobs(pid, pipeline.ID, &gitlab.ListJobsOptions{ListOptions: opts})
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to list jobs of pipeline %d: %w", pipeline.ID, err).Error()), nil
+			}
+			points = append(points, coverageOf(pipeline, jobs, job))
+		}
+
+		jsonData, err := json.Marshal(buildCoverageTrend(ref, points))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,201 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockPipelinesService is a mock implementation of the GitLab pipelines service
+type mockPipelinesService struct {
+	listProjectFunc   func(pid interface{}, opt *gitlab.ListProjectPipelinesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error)
+	getTestReportFunc func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTestReport, *gitlab.Response, error)
+}
+
+// ensure mockPipelinesService implements the gitlab.PipelinesServiceInterface
+var _ gitlab.PipelinesServiceInterface = &mockPipelinesService{}
+
+func (m *mockPipelinesService) ListProjectPipelines(pid interface{}, opt *gitlab.ListProjectPipelinesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+	return m.listProjectFunc(pid, opt, options...)
+}
+
+func (m *mockPipelinesService) GetPipelineTestReport(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTestReport, *gitlab.Response, error) {
+	return m.getTestReportFunc(pid, pipeline, options...)
+}
+
+func (m *mockPipelinesService) CancelPipelineBuild(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelinesService) CreatePipeline(pid interface{}, opt *gitlab.CreatePipelineOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelinesService) DeletePipeline(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockPipelinesService) GetLatestPipeline(pid interface{}, opt *gitlab.GetLatestPipelineOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelinesService) GetPipeline(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelinesService) GetPipelineVariables(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelinesService) RetryPipelineBuild(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelinesService) UpdatePipelineMetadata(pid interface{}, pipeline int, opt *gitlab.UpdatePipelineMetadataOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestBuildTestReport(t *testing.T) {
+	report := &gitlab.PipelineTestReport{
+		TotalTime:    12.5,
+		TotalCount:   5,
+		SuccessCount: 2,
+		FailedCount:  3,
+		TestSuites: []*gitlab.PipelineTestSuites{
+			{
+				Name:         "rspec",
+				TotalTime:    12.5,
+				TotalCount:   5,
+				SuccessCount: 2,
+				FailedCount:  3,
+				TestCases: []*gitlab.PipelineTestCases{
+					{Status: "success", Name: "creates a user", Classname: "UsersController"},
+					{Status: "failed", Name: "creates a user", Classname: "UsersController", SystemOutput: []interface{}{"expected 201", "got 500"}},
+					{Status: "failed", Name: "deletes a user", Classname: "UsersController", File: "spec/users_spec.rb", ExecutionTime: 1.5, StackTrace: strings.Repeat("x", 30) + "the end"},
+					{Status: "failed", Name: "lists users", Classname: "UsersController", SystemOutput: "timeout", RecentFailures: &gitlab.RecentFailures{Count: 4, BaseBranch: "main"}},
+					{Status: "success", Name: "lists projects", Classname: "ProjectsController"},
+				},
+			},
+		},
+	}
+
+	got := buildTestReport(9, report, 20)
+
+	assert.Equal(t, []testSuiteSummary{{Name: "rspec", TotalTime: 12.5, TotalCount: 5, SuccessCount: 2, FailedCount: 3}}, got.Suites)
+	assert.Equal(t, []failedTestCase{
+		{Suite: "rspec", Name: "creates a user", Classname: "UsersController", Status: "failed", Output: "expected 201\ngot 500"},
+		{Suite: "rspec", Name: "deletes a user", Classname: "UsersController", File: "spec/users_spec.rb", Status: "failed", ExecutionTime: 1.5, Output: truncatedOutputMarker + "xxxxxxxxxxxxxthe end"},
+		{Suite: "rspec", Name: "lists users", Classname: "UsersController", Status: "failed", Output: "timeout", RecentFailures: 4, BaseBranch: "main"},
+	}, got.Failed)
+	assert.Equal(t, []flakyTestCase{
+		{Suite: "rspec", Name: "creates a user", Reason: "both passed and failed in this pipeline"},
+		{Suite: "rspec", Name: "lists users", Reason: "also failed 4 times recently on main"},
+	}, got.Flaky)
+}
+
+func TestGetCoverageTrend(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+	created := time.Date(2024, 5, 6, 10, 0, 0, 0, time.UTC)
+
+	jobs := map[int][]*gitlab.Job{
+		3: {{Name: "rspec", Coverage: 80}, {Name: "jest", Coverage: 70}, {Name: "lint"}},
+		2: {{Name: "lint"}},
+		1: {{Name: "rspec", Coverage: 82.5}, {Name: "jest", Coverage: 60}},
+	}
+
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		expected coverageTrend
+	}{
+		{
+			name: "all jobs",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"ref":       "main",
+				"pipelines": float64(3),
+			},
+			expected: coverageTrend{
+				Ref: "main",
+				Pipelines: []coveragePoint{
+					{PipelineID: 1, SHA: "sha1", Status: "success", CreatedAt: &created, Coverage: gitlab.Ptr(71.25), Jobs: map[string]float64{"rspec": 82.5, "jest": 60}},
+					{PipelineID: 2, SHA: "sha2", Status: "failed", CreatedAt: &created},
+					{PipelineID: 3, SHA: "sha3", Status: "success", CreatedAt: &created, Coverage: gitlab.Ptr(75.0), Jobs: map[string]float64{"rspec": 80, "jest": 70}},
+				},
+				Latest: gitlab.Ptr(75.0),
+				Change: gitlab.Ptr(3.75),
+			},
+		},
+		{
+			name: "single job",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"ref":       "main",
+				"pipelines": float64(3),
+				"job":       "rspec",
+			},
+			expected: coverageTrend{
+				Ref: "main",
+				Pipelines: []coveragePoint{
+					{PipelineID: 1, SHA: "sha1", Status: "success", CreatedAt: &created, Coverage: gitlab.Ptr(82.5), Jobs: map[string]float64{"rspec": 82.5}},
+					{PipelineID: 2, SHA: "sha2", Status: "failed", CreatedAt: &created},
+					{PipelineID: 3, SHA: "sha3", Status: "success", CreatedAt: &created, Coverage: gitlab.Ptr(80.0), Jobs: map[string]float64{"rspec": 80}},
+				},
+				Latest: gitlab.Ptr(80.0),
+				Change: gitlab.Ptr(-2.5),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Pipelines: &mockPipelinesService{
+						listProjectFunc: func(pid interface{}, opts *gitlab.ListProjectPipelinesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, "main", *opts.Ref)
+							assert.Equal(t, "finished", *opts.Scope)
+							assert.Equal(t, 3, opts.PerPage)
+							return []*gitlab.PipelineInfo{
+								{ID: 3, SHA: "sha3", Status: "success", CreatedAt: &created},
+								{ID: 2, SHA: "sha2", Status: "failed", CreatedAt: &created},
+								{ID: 1, SHA: "sha1", Status: "success", CreatedAt: &created},
+							}, ok, nil
+						},
+					},
+					Jobs: &mockJobsService{
+						listPipelineJobsFunc: func(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+							return jobs[pipelineID], ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := GetCoverageTrend(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
+
+			var got coverageTrend
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,538 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// scheduleVariable is a variable passed to the pipelines of a schedule
+type scheduleVariable struct {
+	Key          string `json:"key"`
+	VariableType string `json:"variable_type"`
+	Value        string `json:"value,omitempty"`
+}
+
+// pipelineScheduleSummary is a pipeline schedule with what is needed to tell whether it still runs
+type pipelineScheduleSummary struct {
+	ID           int        `json:"id"`
+	Description  string     `json:"description"`
+	Ref          string     `json:"ref"`
+	Cron         string     `json:"cron"`
+	CronTimezone string     `json:"cron_timezone"`
+	Active       bool       `json:"active"`
+	NextRunAt    *time.Time `json:"next_run_at,omitempty"`
+	Owner        string     `json:"owner,omitempty"`
+	// OwnerState is set when the owner is no longer active, in which case the schedule fails to create pipelines
+	OwnerState   string               `json:"owner_state,omitempty"`
+	LastPipeline *gitlab.LastPipeline `json:"last_pipeline,omitempty"`
+	Variables    []scheduleVariable   `json:"variables,omitempty"`
+}
+
+// summarizePipelineSchedule flattens a pipeline schedule. Variable values are only included when asked for.
+func summarizePipelineSchedule(schedule *gitlab.PipelineSchedule, includeValues bool) pipelineScheduleSummary {
+	summary := pipelineScheduleSummary{
+		ID:           schedule.ID,
+		Description:  schedule.Description,
+		Ref:          schedule.Ref,
+		Cron:         schedule.Cron,
+		CronTimezone: schedule.CronTimezone,
+		Active:       schedule.Active,
+		NextRunAt:    schedule.NextRunAt,
+		LastPipeline: schedule.LastPipeline,
+	}
+	if schedule.Owner != nil {
+		summary.Owner = schedule.Owner.Username
+		if schedule.Owner.State != "" && schedule.Owner.State != "active" {
+			summary.OwnerState = schedule.Owner.State
+		}
+	}
+	for _, variable := range schedule.Variables {
+		v := scheduleVariable{Key: variable.Key, VariableType: string(variable.VariableType)}
+		if includeValues {
+			v.Value = variable.Value
+		}
+		summary.Variables = append(summary.Variables, v)
+	}
+	return summary
+}
+
+// withScheduleTarget adds the parameters identifying a pipeline schedule
+func withScheduleTarget(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		)(tool)
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		)(tool)
+		mcp.WithNumber("schedule_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_SCHEDULE_ID_DESCRIPTION", "The ID of the pipeline schedule")),
+		)(tool)
+	}
+}
+
+// readScheduleTarget returns the project and the pipeline schedule ID from the request
+func readScheduleTarget(r mcp.CallToolRequest) (string, int, error) {
+	namespace, err := requiredParam[string](r, "namespace")
+	if err != nil {
+		return "", 0, err
+	}
+	project, err := requiredParam[string](r, "project")
+	if err != nil {
+		return "", 0, err
+	}
+	scheduleID, err := RequiredInt(r, "schedule_id")
+	if err != nil {
+		return "", 0, err
+	}
+	return fmt.Sprintf("%s/%s", namespace, project), scheduleID, nil
+}
+
+// withScheduleSettings adds the settings of a pipeline schedule, required ones are required when creating
+func withScheduleSettings(t translations.TranslationHelperFunc, create bool) mcp.ToolOption {
+	required := func() mcp.PropertyOption {
+		if create {
+			return mcp.Required()
+		}
+		return func(map[string]interface{}) {}
+	}
+	return func(tool *mcp.Tool) {
+		mcp.WithString("description",
+			required(),
+			mcp.Description(t("PARAM_SCHEDULE_DESCRIPTION_DESCRIPTION", "The description of the schedule")),
+		)(tool)
+		mcp.WithString("ref",
+			required(),
+			mcp.Description(t("PARAM_SCHEDULE_REF_DESCRIPTION", "The branch or tag the pipelines run for")),
+		)(tool)
+		mcp.WithString("cron",
+			required(),
+			mcp.Description(t("PARAM_SCHEDULE_CRON_DESCRIPTION", "When the pipelines run, in cron syntax, e.g. 0 2 * * *")),
+		)(tool)
+		mcp.WithString("cron_timezone",
+			mcp.Description(t("PARAM_SCHEDULE_CRON_TIMEZONE_DESCRIPTION", "The timezone of cron, e.g. UTC or Europe/Berlin. Defaults to UTC")),
+		)(tool)
+		mcp.WithBoolean("active",
+			mcp.Description(t("PARAM_SCHEDULE_ACTIVE_DESCRIPTION", "Whether the schedule runs. Defaults to true when creating")),
+		)(tool)
+	}
+}
+
+// scheduleSettings are the settings of a pipeline schedule given in a request, nil when not given
+type scheduleSettings struct {
+	description  *string
+	ref          *string
+	cron         *string
+	cronTimezone *string
+	active       *bool
+}
+
+// readScheduleSettings returns the pipeline schedule settings from the request, leaving the missing and empty ones nil
+func readScheduleSettings(r mcp.CallToolRequest) (scheduleSettings, error) {
+	var settings scheduleSettings
+	for name, setting := range map[string]**string{
+		"description":   &settings.description,
+		"ref":           &settings.ref,
+		"cron":          &settings.cron,
+		"cron_timezone": &settings.cronTimezone,
+	} {
+		value, err := OptionalParam[string](r, name)
+		if err != nil {
+			return settings, err
+		}
+		if value != "" {
+			*setting = gitlab.Ptr(value)
+		}
+	}
+	active, err := optionalPtrParam[bool](r, "active")
+	if err != nil {
+		return settings, err
+	}
+	settings.active = active
+	return settings, nil
+}
+
+// scheduleResult marshals a pipeline schedule as the tool response
+func scheduleResult(v interface{}) (*mcp.CallToolResult, error) {
+	jsonData, err := json.Marshal(v)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// ListPipelineSchedules returns a tool for listing the pipeline schedules of a project
+func ListPipelineSchedules(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_pipeline_schedules",
+		mcp.WithDescription(t("TOOL_LIST_PIPELINE_SCHEDULES_DESCRIPTION", "List the pipeline schedules of a project with their ref, cron, owner and next run")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := gitlab.ListPipelineSchedulesOptions(pagination)
+		schedules, _, err := client.PipelineSchedules.ListPipelineSchedules(fmt.Sprintf("%s/%s", namespace, project), &opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list pipeline schedules: %w", err).Error()), nil
+		}
+
+		summaries := make([]pipelineScheduleSummary, 0, len(schedules))
+		for _, schedule := range schedules {
+			summaries = append(summaries, summarizePipelineSchedule(schedule, false))
+		}
+		return scheduleResult(summaries)
+	}
+
+	return tool, handler
+}
+
+// GetPipelineSchedule returns a tool for getting a pipeline schedule with its last pipeline and variables
+func GetPipelineSchedule(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_pipeline_schedule",
+		mcp.WithDescription(t("TOOL_GET_PIPELINE_SCHEDULE_DESCRIPTION", "Get a pipeline schedule with the status of its last pipeline, its owner and its variables. Variable values are left out unless include_values is set")),
+		withScheduleTarget(t),
+		mcp.WithBoolean("include_values",
+			mcp.Description(t("PARAM_SCHEDULE_INCLUDE_VALUES_DESCRIPTION", "Return the values of the schedule variables")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		pid, scheduleID, err := readScheduleTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		includeValues, err := OptionalParam[bool](r, "include_values")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		schedule, _, err := client.PipelineSchedules.GetPipelineSchedule(pid, scheduleID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get pipeline schedule: %w", err).Error()), nil
+		}
+		return scheduleResult(summarizePipelineSchedule(schedule, includeValues))
+	}
+
+	return tool, handler
+}
+
+// CreatePipelineSchedule returns a tool for creating a pipeline schedule
+func CreatePipelineSchedule(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"create_pipeline_schedule",
+		mcp.WithDescription(t("TOOL_CREATE_PIPELINE_SCHEDULE_DESCRIPTION", "Create a pipeline schedule owned by the current user")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		withScheduleSettings(t, true),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		for _, name := range []string{"description", "ref", "cron"} {
+			if _, err := requiredParam[string](r, name); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+		settings, err := readScheduleSettings(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		schedule, _, err := client.PipelineSchedules.CreatePipelineSchedule(fmt.Sprintf("%s/%s", namespace, project), &gitlab.CreatePipelineScheduleOptions{
+			Description:  settings.description,
+			Ref:          settings.ref,
+			Cron:         settings.cron,
+			CronTimezone: settings.cronTimezone,
+			Active:       settings.active,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to create pipeline schedule: %w", err).Error()), nil
+		}
+		return scheduleResult(summarizePipelineSchedule(schedule, false))
+	}
+
+	return tool, handler
+}
+
+// UpdatePipelineSchedule returns a tool for changing the settings of a pipeline schedule
+func UpdatePipelineSchedule(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"update_pipeline_schedule",
+		mcp.WithDescription(t("TOOL_UPDATE_PIPELINE_SCHEDULE_DESCRIPTION", "Update a pipeline schedule, e.g. fix its ref or cron, or activate it. Only the given settings change")),
+		withScheduleTarget(t),
+		withScheduleSettings(t, false),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		pid, scheduleID, err := readScheduleTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		settings, err := readScheduleSettings(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if settings == (scheduleSettings{}) {
+			return mcp.NewToolResultError("at least one of description, ref, cron, cron_timezone and active is required"), nil
+		}
+
+		schedule, _, err := client.PipelineSchedules.EditPipelineSchedule(pid, scheduleID, &gitlab.EditPipelineScheduleOptions{
+			Description:  settings.description,
+			Ref:          settings.ref,
+			Cron:         settings.cron,
+			CronTimezone: settings.cronTimezone,
+			Active:       settings.active,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to update pipeline schedule: %w", err).Error()), nil
+		}
+		return scheduleResult(summarizePipelineSchedule(schedule, false))
+	}
+
+	return tool, handler
+}
+
+// TakePipelineScheduleOwnership returns a tool for making the current user the owner of a pipeline schedule
+func TakePipelineScheduleOwnership(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"take_pipeline_schedule_ownership",
+		mcp.WithDescription(t("TOOL_TAKE_PIPELINE_SCHEDULE_OWNERSHIP_DESCRIPTION", "Make the current user the owner of a pipeline schedule. Pipelines of a schedule run as its owner, so this fixes schedules whose owner was blocked or lost access")),
+		withScheduleTarget(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		pid, scheduleID, err := readScheduleTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		schedule, _, err := client.PipelineSchedules.TakeOwnershipOfPipelineSchedule(pid, scheduleID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to take ownership of pipeline schedule: %w", err).Error()), nil
+		}
+		return scheduleResult(summarizePipelineSchedule(schedule, false))
+	}
+
+	return tool, handler
+}
+
+// PlayPipelineSchedule returns a tool for running a pipeline schedule now
+func PlayPipelineSchedule(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"play_pipeline_schedule",
+		mcp.WithDescription(t("TOOL_PLAY_PIPELINE_SCHEDULE_DESCRIPTION", "Run a pipeline schedule now. The pipeline is created asynchronously, use get_pipeline_schedule to find it")),
+		withScheduleTarget(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		pid, scheduleID, err := readScheduleTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if _, err := client.PipelineSchedules.RunPipelineSchedule(pid, scheduleID); err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to play pipeline schedule: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Pipeline schedule %d queued to run", scheduleID)), nil
+	}
+
+	return tool, handler
+}
+
+// SetPipelineScheduleVariable returns a tool for creating or updating a variable of a pipeline schedule
+func SetPipelineScheduleVariable(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"set_pipeline_schedule_variable",
+		mcp.WithDescription(t("TOOL_SET_PIPELINE_SCHEDULE_VARIABLE_DESCRIPTION", "Set a variable passed to the pipelines of a schedule, creating it if it does not exist. The value is not echoed back")),
+		withScheduleTarget(t),
+		mcp.WithString("key",
+			mcp.Required(),
+			mcp.Description(t("PARAM_SCHEDULE_VARIABLE_KEY_DESCRIPTION", "The key of the variable")),
+		),
+		mcp.WithString("value",
+			mcp.Required(),
+			mcp.Description(t("PARAM_SCHEDULE_VARIABLE_VALUE_DESCRIPTION", "The value of the variable")),
+		),
+		mcp.WithString("variable_type",
+			mcp.Description(t("PARAM_VARIABLE_TYPE_DESCRIPTION", "The type of the variable")),
+			mcp.Enum("env_var", "file"),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		pid, scheduleID, err := readScheduleTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		key, err := requiredParam[string](r, "key")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		value, err := requiredParam[string](r, "value")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		variableType, err := OptionalParam[string](r, "variable_type")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		var typeValue *gitlab.VariableTypeValue
+		switch variableType {
+		case "":
+		case "env_var", "file":
+			typeValue = gitlab.Ptr(gitlab.VariableTypeValue(variableType))
+		default:
+			return mcp.NewToolResultError(fmt.Sprintf("parameter variable_type must be env_var or file, got %s", variableType)), nil
+		}
+
+		schedule, _, err := client.PipelineSchedules.GetPipelineSchedule(pid, scheduleID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get pipeline schedule: %w", err).Error()), nil
+		}
+		exists := false
+		for _, variable := range schedule.Variables {
+			if variable.Key == key {
+				exists = true
+				break
+			}
+		}
+
+		var variable *gitlab.PipelineVariable
+		if exists {
+			variable, _, err = client.PipelineSchedules.EditPipelineScheduleVariable(pid, scheduleID, key, &gitlab.EditPipelineScheduleVariableOptions{
+				Value:        gitlab.Ptr(value),
+				VariableType: typeValue,
+			})
+		} else {
+			variable, _, err = client.PipelineSchedules.CreatePipelineScheduleVariable(pid, scheduleID, &gitlab.CreatePipelineScheduleVariableOptions{
+				Key:          gitlab.Ptr(key),
+				Value:        gitlab.Ptr(value),
+				VariableType: typeValue,
+			})
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to set pipeline schedule variable: %w", err).Error()), nil
+		}
+		return scheduleResult(scheduleVariable{Key: variable.Key, VariableType: string(variable.VariableType)})
+	}
+
+	return tool, handler
+}
+
+// DeletePipelineScheduleVariable returns a tool for deleting a variable of a pipeline schedule
+func DeletePipelineScheduleVariable(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"delete_pipeline_schedule_variable",
+		mcp.WithDescription(t("TOOL_DELETE_PIPELINE_SCHEDULE_VARIABLE_DESCRIPTION", "Delete a variable of a pipeline schedule")),
+		withScheduleTarget(t),
+		mcp.WithString("key",
+			mcp.Required(),
+			mcp.Description(t("PARAM_SCHEDULE_VARIABLE_KEY_DESCRIPTION", "The key of the variable")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		pid, scheduleID, err := readScheduleTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		key, err := requiredParam[string](r, "key")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if _, _, err := client.PipelineSchedules.DeletePipelineScheduleVariable(pid, scheduleID, key); err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to delete pipeline schedule variable: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Variable %s deleted from pipeline schedule %d", key, scheduleID)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,361 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockPipelineSchedulesService is a mock implementation of the GitLab pipeline schedules service
+type mockPipelineSchedulesService struct {
+	getFunc            func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
+	createVariableFunc func(pid interface{}, schedule int, opt *gitlab.CreatePipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
+	editVariableFunc   func(pid interface{}, schedule int, key string, opt *gitlab.EditPipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
+	editFunc           func(pid interface{}, schedule int, opt *gitlab.EditPipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
+}
+
+// ensure mockPipelineSchedulesService implements the gitlab.PipelineSchedulesServiceInterface
+var _ gitlab.PipelineSchedulesServiceInterface = &mockPipelineSchedulesService{}
+
+func (m *mockPipelineSchedulesService) GetPipelineSchedule(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+	return m.getFunc(pid, schedule, options...)
+}
+
+func (m *mockPipelineSchedulesService) CreatePipelineScheduleVariable(pid interface{}, schedule int, opt *gitlab.CreatePipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error) {
+	return m.createVariableFunc(pid, schedule, opt, options...)
+}
+
+func (m *mockPipelineSchedulesService) EditPipelineScheduleVariable(pid interface{}, schedule int, key string, opt *gitlab.EditPipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error) {
+	return m.editVariableFunc(pid, schedule, key, opt, options...)
+}
+
+func (m *mockPipelineSchedulesService) EditPipelineSchedule(pid interface{}, schedule int, opt *gitlab.EditPipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+	return m.editFunc(pid, schedule, opt, options...)
+}
+
+func (m *mockPipelineSchedulesService) CreatePipelineSchedule(pid interface{}, opt *gitlab.CreatePipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelineSchedulesService) DeletePipelineSchedule(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockPipelineSchedulesService) DeletePipelineScheduleVariable(pid interface{}, schedule int, key string, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelineSchedulesService) ListPipelineSchedules(pid interface{}, opt *gitlab.ListPipelineSchedulesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineSchedule, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelineSchedulesService) ListPipelinesTriggeredBySchedule(pid interface{}, schedule int, opt *gitlab.ListPipelinesTriggeredByScheduleOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Pipeline, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelineSchedulesService) RunPipelineSchedule(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockPipelineSchedulesService) TakeOwnershipOfPipelineSchedule(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestGetPipelineSchedule(t *testing.T) {
+	nextRun := time.Date(2024, 5, 7, 2, 0, 0, 0, time.UTC)
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+	schedule := &gitlab.PipelineSchedule{
+		ID:           7,
+		Description:  "Nightly",
+		Ref:          "main",
+		Cron:         "0 2 * * *",
+		CronTimezone: "UTC",
+		Active:       true,
+		NextRunAt:    &nextRun,
+		Owner:        &gitlab.User{Username: "alice", State: "blocked"},
+		LastPipeline: &gitlab.LastPipeline{ID: 42, SHA: "abc123", Ref: "main", Status: "failed", WebURL: "https://gitlab.example.com/group/project/-/pipelines/42"},
+		Variables:    []*gitlab.PipelineVariable{{Key: "DEPLOY_TOKEN", Value: "secret", VariableType: gitlab.EnvVariableType}},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		getError      error
+		expected      pipelineScheduleSummary
+		expectedError string
+	}{
+		{
+			name: "schedule with blocked owner and failed last pipeline",
+			args: map[string]interface{}{
+				"namespace":   "group",
+				"project":     "project",
+				"schedule_id": float64(7),
+			},
+			expected: pipelineScheduleSummary{
+				ID:           7,
+				Description:  "Nightly",
+				Ref:          "main",
+				Cron:         "0 2 * * *",
+				CronTimezone: "UTC",
+				Active:       true,
+				NextRunAt:    &nextRun,
+				Owner:        "alice",
+				OwnerState:   "blocked",
+				LastPipeline: schedule.LastPipeline,
+				Variables:    []scheduleVariable{{Key: "DEPLOY_TOKEN", VariableType: "env_var"}},
+			},
+		},
+		{
+			name: "schedule with variable values",
+			args: map[string]interface{}{
+				"namespace":      "group",
+				"project":        "project",
+				"schedule_id":    float64(7),
+				"include_values": true,
+			},
+			expected: pipelineScheduleSummary{
+				ID:           7,
+				Description:  "Nightly",
+				Ref:          "main",
+				Cron:         "0 2 * * *",
+				CronTimezone: "UTC",
+				Active:       true,
+				NextRunAt:    &nextRun,
+				Owner:        "alice",
+				OwnerState:   "blocked",
+				LastPipeline: schedule.LastPipeline,
+				Variables:    []scheduleVariable{{Key: "DEPLOY_TOKEN", VariableType: "env_var", Value: "secret"}},
+			},
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace":   "group",
+				"project":     "project",
+				"schedule_id": float64(7),
+			},
+			getError:      fmt.Errorf("API error"),
+			expectedError: "failed to get pipeline schedule: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					PipelineSchedules: &mockPipelineSchedulesService{
+						getFunc: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, 7, id)
+							if tc.getError != nil {
+								return nil, nil, tc.getError
+							}
+							return schedule, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := GetPipelineSchedule(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got pipelineScheduleSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestUpdatePipelineSchedule(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedOpts  *gitlab.EditPipelineScheduleOptions
+		expectedError string
+	}{
+		{
+			name: "deactivate and move to another ref",
+			args: map[string]interface{}{
+				"namespace":   "group",
+				"project":     "project",
+				"schedule_id": float64(7),
+				"ref":         "release",
+				"active":      false,
+			},
+			expectedOpts: &gitlab.EditPipelineScheduleOptions{Ref: gitlab.Ptr("release"), Active: gitlab.Ptr(false)},
+		},
+		{
+			name: "nothing to update",
+			args: map[string]interface{}{
+				"namespace":   "group",
+				"project":     "project",
+				"schedule_id": float64(7),
+			},
+			expectedError: "at least one of description, ref, cron, cron_timezone and active is required",
+		},
+		{
+			name: "active given as a string",
+			args: map[string]interface{}{
+				"namespace":   "group",
+				"project":     "project",
+				"schedule_id": float64(7),
+				"active":      "false",
+			},
+			expectedError: "parameter active is not of type bool, is string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					PipelineSchedules: &mockPipelineSchedulesService{
+						editFunc: func(pid interface{}, id int, opts *gitlab.EditPipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, 7, id)
+							assert.Equal(t, tc.expectedOpts, opts)
+							return &gitlab.PipelineSchedule{ID: 7, Ref: "release"}, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := UpdatePipelineSchedule(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got pipelineScheduleSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, pipelineScheduleSummary{ID: 7, Ref: "release"}, got)
+		})
+	}
+}
+
+func TestSetPipelineScheduleVariable(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectCreate  bool
+		expectedError string
+	}{
+		{
+			name: "existing variable is updated",
+			args: map[string]interface{}{
+				"namespace":   "group",
+				"project":     "project",
+				"schedule_id": float64(7),
+				"key":         "TARGET",
+				"value":       "staging",
+			},
+		},
+		{
+			name: "missing variable is created",
+			args: map[string]interface{}{
+				"namespace":     "group",
+				"project":       "project",
+				"schedule_id":   float64(7),
+				"key":           "NEW",
+				"value":         "staging",
+				"variable_type": "file",
+			},
+			expectCreate: true,
+		},
+		{
+			name: "invalid variable type",
+			args: map[string]interface{}{
+				"namespace":     "group",
+				"project":       "project",
+				"schedule_id":   float64(7),
+				"key":           "TARGET",
+				"value":         "staging",
+				"variable_type": "secret",
+			},
+			expectedError: "parameter variable_type must be env_var or file, got secret",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			created, edited := false, false
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					PipelineSchedules: &mockPipelineSchedulesService{
+						getFunc: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+							return &gitlab.PipelineSchedule{ID: 7, Variables: []*gitlab.PipelineVariable{{Key: "TARGET", Value: "production", VariableType: gitlab.EnvVariableType}}}, ok, nil
+						},
+						createVariableFunc: func(pid interface{}, id int, opts *gitlab.CreatePipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error) {
+							created = true
+							assert.Equal(t, "NEW", *opts.Key)
+							assert.Equal(t, "staging", *opts.Value)
+							assert.Equal(t, gitlab.FileVariableType, *opts.VariableType)
+							return &gitlab.PipelineVariable{Key: *opts.Key, Value: *opts.Value, VariableType: *opts.VariableType}, ok, nil
+						},
+						editVariableFunc: func(pid interface{}, id int, key string, opts *gitlab.EditPipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error) {
+							edited = true
+							assert.Equal(t, "TARGET", key)
+							assert.Equal(t, "staging", *opts.Value)
+							assert.Nil(t, opts.VariableType)
+							return &gitlab.PipelineVariable{Key: key, Value: *opts.Value, VariableType: gitlab.EnvVariableType}, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := SetPipelineScheduleVariable(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			assert.Equal(t, tc.expectCreate, created)
+			assert.Equal(t, !tc.expectCreate, edited)
+			assert.NotContains(t, textContent.Text, "staging")
+		})
+	}
+}
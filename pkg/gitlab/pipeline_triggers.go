@@ -0,0 +1,200 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// pipelineTriggerSummary is a pipeline trigger without its token
+type pipelineTriggerSummary struct {
+	ID          int        `json:"id"`
+	Description string     `json:"description"`
+	Owner       string     `json:"owner,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	LastUsed    *time.Time `json:"last_used,omitempty"`
+}
+
+// summarizePipelineTrigger flattens a pipeline trigger, leaving out its token
+func summarizePipelineTrigger(trigger *gitlab.PipelineTrigger) pipelineTriggerSummary {
+	summary := pipelineTriggerSummary{
+		ID:          trigger.ID,
+		Description: trigger.Description,
+		CreatedAt:   trigger.CreatedAt,
+		LastUsed:    trigger.LastUsed,
+	}
+	if trigger.Owner != nil {
+		summary.Owner = trigger.Owner.Username
+	}
+	return summary
+}
+
+// pipelineSummary is a pipeline with the fields needed to follow it up
+type pipelineSummary struct {
+	ID        int        `json:"id"`
+	IID       int        `json:"iid"`
+	Status    string     `json:"status"`
+	Source    string     `json:"source,omitempty"`
+	Ref       string     `json:"ref"`
+	SHA       string     `json:"sha"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	WebURL    string     `json:"web_url"`
+}
+
+// summarizePipeline flattens a pipeline
+func summarizePipeline(pipeline *gitlab.Pipeline) pipelineSummary {
+	return pipelineSummary{
+		ID:        pipeline.ID,
+		IID:       pipeline.IID,
+		Status:    pipeline.Status,
+		Source:    pipeline.Source,
+		Ref:       pipeline.Ref,
+		SHA:       pipeline.SHA,
+		CreatedAt: pipeline.CreatedAt,
+		WebURL:    pipeline.WebURL,
+	}
+}
+
+// ListPipelineTriggers returns a tool for listing the pipeline triggers of a project
+func ListPipelineTriggers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_pipeline_triggers",
+		mcp.WithDescription(t("TOOL_LIST_PIPELINE_TRIGGERS_DESCRIPTION", "List the pipeline triggers of a project with their owner and when they were last used. Tokens are never returned")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := gitlab.ListPipelineTriggersOptions(pagination)
+		triggers, _, err := client.PipelineTriggers.ListPipelineTriggers(fmt.Sprintf("%s/%s", namespace, project), &opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list pipeline triggers: %w", err).Error()), nil
+		}
+
+		summaries := make([]pipelineTriggerSummary, 0, len(triggers))
+		for _, trigger := range triggers {
+			summaries = append(summaries, summarizePipelineTrigger(trigger))
+		}
+
+		jsonData, err := json.Marshal(summaries)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// RunPipelineTrigger returns a tool for creating a pipeline with a pipeline trigger
+func RunPipelineTrigger(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"run_pipeline_trigger",
+		mcp.WithDescription(t("TOOL_RUN_PIPELINE_TRIGGER_DESCRIPTION", "Create a pipeline for a ref with a pipeline trigger. The token of the trigger is looked up, so only triggers owned by the current user can be run")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithNumber("trigger_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_TRIGGER_ID_DESCRIPTION", "The ID of the pipeline trigger, as returned by list_pipeline_triggers")),
+		),
+		mcp.WithString("ref",
+			mcp.Required(),
+			mcp.Description(t("PARAM_TRIGGER_REF_DESCRIPTION", "The branch or tag to run the pipeline for")),
+		),
+		mcp.WithObject("variables",
+			mcp.Description(t("PARAM_TRIGGER_VARIABLES_DESCRIPTION", "Variables passed to the pipeline, as an object of keys to string values")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		triggerID, err := RequiredInt(r, "trigger_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref, err := requiredParam[string](r, "ref")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		rawVariables, err := OptionalParam[map[string]interface{}](r, "variables")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		variables, err := stringVariables(rawVariables)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opts := &gitlab.RunPipelineTriggerOptions{Ref: gitlab.Ptr(ref), Variables: variables}
+		pid := fmt.Sprintf("%s/%s", namespace, project)
+
+		trigger, _, err := client.PipelineTriggers.GetPipelineTrigger(pid, triggerID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get pipeline trigger: %w", err).Error()), nil
+		}
+		opts.Token = gitlab.Ptr(trigger.Token)
+
+		pipeline, _, err := client.PipelineTriggers.RunPipelineTrigger(pid, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to run pipeline trigger: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(summarizePipeline(pipeline))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
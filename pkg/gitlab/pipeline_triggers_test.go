@@ -0,0 +1,128 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockPipelineTriggersService is a mock implementation of the GitLab pipeline triggers service
+type mockPipelineTriggersService struct {
+	getFunc func(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error)
+	runFunc func(pid interface{}, opt *gitlab.RunPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error)
+}
+
+// ensure mockPipelineTriggersService implements the gitlab.PipelineTriggersServiceInterface
+var _ gitlab.PipelineTriggersServiceInterface = &mockPipelineTriggersService{}
+
+func (m *mockPipelineTriggersService) GetPipelineTrigger(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+	return m.getFunc(pid, trigger, options...)
+}
+
+func (m *mockPipelineTriggersService) RunPipelineTrigger(pid interface{}, opt *gitlab.RunPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+	return m.runFunc(pid, opt, options...)
+}
+
+func (m *mockPipelineTriggersService) AddPipelineTrigger(pid interface{}, opt *gitlab.AddPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelineTriggersService) DeletePipelineTrigger(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockPipelineTriggersService) EditPipelineTrigger(pid interface{}, trigger int, opt *gitlab.EditPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelineTriggersService) ListPipelineTriggers(pid interface{}, opt *gitlab.ListPipelineTriggersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineTrigger, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockPipelineTriggersService) TakeOwnershipOfPipelineTrigger(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestRunPipelineTrigger(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name              string
+		args              map[string]interface{}
+		expectedVariables map[string]string
+		expected          pipelineSummary
+		expectedError     string
+	}{
+		{
+			name: "run with variables",
+			args: map[string]interface{}{
+				"namespace":  "group",
+				"project":    "project",
+				"trigger_id": float64(3),
+				"ref":        "main",
+				"variables":  map[string]interface{}{"TARGET": "staging"},
+			},
+			expectedVariables: map[string]string{"TARGET": "staging"},
+			expected:          pipelineSummary{ID: 42, IID: 12, Status: "created", Source: "trigger", Ref: "main", SHA: "abc123", WebURL: "https://gitlab.example.com/group/project/-/pipelines/42"},
+		},
+		{
+			name: "non-string variable",
+			args: map[string]interface{}{
+				"namespace":  "group",
+				"project":    "project",
+				"trigger_id": float64(3),
+				"ref":        "main",
+				"variables":  map[string]interface{}{"RETRIES": float64(3)},
+			},
+			expectedError: "variable RETRIES must be a string, got float64",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					PipelineTriggers: &mockPipelineTriggersService{
+						getFunc: func(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, 3, trigger)
+							return &gitlab.PipelineTrigger{ID: 3, Token: "glptt-0123456789"}, ok, nil
+						},
+						runFunc: func(pid interface{}, opts *gitlab.RunPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+							assert.Equal(t, "glptt-0123456789", *opts.Token)
+							assert.Equal(t, "main", *opts.Ref)
+							assert.Equal(t, tc.expectedVariables, opts.Variables)
+							return &gitlab.Pipeline{ID: 42, IID: 12, Status: "created", Source: "trigger", Ref: "main", SHA: "abc123", WebURL: "https://gitlab.example.com/group/project/-/pipelines/42"}, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := RunPipelineTrigger(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			assert.NotContains(t, textContent.Text, "glptt-")
+			var got pipelineSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
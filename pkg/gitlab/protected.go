@@ -0,0 +1,737 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// accessLevelParams maps the access level names accepted by the protect tools to GitLab access levels
+var accessLevelParams = map[string]gitlab.AccessLevelValue{
+	"no_access":  gitlab.NoPermissions,
+	"developer":  gitlab.DeveloperPermissions,
+	"maintainer": gitlab.MaintainerPermissions,
+	"admin":      gitlab.AdminPermissions,
+}
+
+// optionalAccessLevel parses an optional access level parameter from the request
+func optionalAccessLevel(r mcp.CallToolRequest, p string) (*gitlab.AccessLevelValue, error) {
+	v, err := OptionalParam[string](r, p)
+	if err != nil || v == "" {
+		return nil, err
+	}
+	level, ok := accessLevelParams[v]
+	if !ok {
+		return nil, fmt.Errorf("parameter %s must be one of no_access, developer, maintainer or admin, got %s", p, v)
+	}
+	return &level, nil
+}
+
+// describeAccessLevel explains who an access level grants access to. Higher roles always
+// include the lower ones, so a level is described as the set of roles that qualify.
+func describeAccessLevel(level gitlab.AccessLevelValue) string {
+	switch {
+	case level == gitlab.NoPermissions:
+		return "no one"
+	case level <= gitlab.DeveloperPermissions:
+		return "developers and maintainers"
+	case level <= gitlab.MaintainerPermissions:
+		return "maintainers"
+	case level <= gitlab.OwnerPermissions:
+		return "owners"
+	default:
+		return "administrators"
+	}
+}
+
+// describeBranchAccess explains the combined effect of a list of branch access entries.
+// Entries are additive: anyone matching at least one of them is allowed.
+func describeBranchAccess(levels []*gitlab.BranchAccessDescription) string {
+	if len(levels) == 0 {
+		return "no one"
+	}
+
+	var who []string
+	for _, level := range levels {
+		switch {
+		case level.UserID != 0:
+			who = append(who, fmt.Sprintf("user %s", level.AccessLevelDescription))
+		case level.GroupID != 0:
+			who = append(who, fmt.Sprintf("members of group %s", level.AccessLevelDescription))
+		case level.DeployKeyID != 0:
+			who = append(who, fmt.Sprintf("deploy key %s", level.AccessLevelDescription))
+		default:
+			who = append(who, describeAccessLevel(level.AccessLevel))
+		}
+	}
+	return strings.Join(who, ", ")
+}
+
+// describeTagAccess explains the combined effect of a list of tag access entries
+func describeTagAccess(levels []*gitlab.TagAccessDescription) string {
+	if len(levels) == 0 {
+		return "no one"
+	}
+
+	var who []string
+	for _, level := range levels {
+		switch {
+		case level.UserID != 0:
+			who = append(who, fmt.Sprintf("user %s", level.AccessLevelDescription))
+		case level.GroupID != 0:
+			who = append(who, fmt.Sprintf("members of group %s", level.AccessLevelDescription))
+		default:
+			who = append(who, describeAccessLevel(level.AccessLevel))
+		}
+	}
+	return strings.Join(who, ", ")
+}
+
+// protectedBranchSummary is a protected branch with its effective access in plain terms
+type protectedBranchSummary struct {
+	*gitlab.ProtectedBranch
+	EffectiveAccess string `json:"effective_access"`
+}
+
+// summarizeProtectedBranch explains a protected branch rule in plain terms
+func summarizeProtectedBranch(b *gitlab.ProtectedBranch) protectedBranchSummary {
+	forcePush := "not allowed"
+	if b.AllowForcePush {
+		forcePush = "allowed for those who can push"
+	}
+	codeOwners := "not required"
+	if b.CodeOwnerApprovalRequired {
+		codeOwners = "required for changes to files with code owners"
+	}
+
+	return protectedBranchSummary{
+		ProtectedBranch: b,
+		EffectiveAccess: fmt.Sprintf(
+			"Branches matching %q can be pushed to by %s and merged into by %s. Force push is %s. Code owner approval is %s. Unprotecting is allowed for %s.",
+			b.Name,
+			describeBranchAccess(b.PushAccessLevels),
+			describeBranchAccess(b.MergeAccessLevels),
+			forcePush,
+			codeOwners,
+			describeBranchAccess(b.UnprotectAccessLevels),
+		),
+	}
+}
+
+// protectedTagSummary is a protected tag with its effective access in plain terms
+type protectedTagSummary struct {
+	*gitlab.ProtectedTag
+	EffectiveAccess string `json:"effective_access"`
+}
+
+// summarizeProtectedTag explains a protected tag rule in plain terms
+func summarizeProtectedTag(tag *gitlab.ProtectedTag) protectedTagSummary {
+	return protectedTagSummary{
+		ProtectedTag:    tag,
+		EffectiveAccess: fmt.Sprintf("Tags matching %q can be created by %s.", tag.Name, describeTagAccess(tag.CreateAccessLevels)),
+	}
+}
+
+// pushRulesSummary is a project's push rules with the enabled rules in plain terms
+type pushRulesSummary struct {
+	*gitlab.ProjectPushRules
+	EffectiveRules []string `json:"effective_rules"`
+}
+
+// summarizePushRules lists the push rules that are actually enforced
+func summarizePushRules(rules *gitlab.ProjectPushRules) pushRulesSummary {
+	var effective []string
+	if rules.CommitMessageRegex != "" {
+		effective = append(effective, fmt.Sprintf("Commit messages must match %q", rules.CommitMessageRegex))
+	}
+	if rules.CommitMessageNegativeRegex != "" {
+		effective = append(effective, fmt.Sprintf("Commit messages must not match %q", rules.CommitMessageNegativeRegex))
+	}
+	if rules.BranchNameRegex != "" {
+		effective = append(effective, fmt.Sprintf("Branch names must match %q", rules.BranchNameRegex))
+	}
+	if rules.AuthorEmailRegex != "" {
+		effective = append(effective, fmt.Sprintf("Commit author emails must match %q", rules.AuthorEmailRegex))
+	}
+	if rules.FileNameRegex != "" {
+		effective = append(effective, fmt.Sprintf("Files matching %q cannot be pushed", rules.FileNameRegex))
+	}
+	if rules.MaxFileSize > 0 {
+		effective = append(effective, fmt.Sprintf("Files larger than %d MB cannot be pushed", rules.MaxFileSize))
+	}
+	if rules.DenyDeleteTag {
+		effective = append(effective, "Tags cannot be deleted with git push")
+	}
+	if rules.MemberCheck {
+		effective = append(effective, "Commit authors must be GitLab users")
+	}
+	if rules.PreventSecrets {
+		effective = append(effective, "Files that are likely to contain secrets are rejected")
+	}
+	if rules.CommitCommitterCheck {
+		effective = append(effective, "Committer email must be one of the pusher's verified emails")
+	}
+	if rules.CommitCommitterNameCheck {
+		effective = append(effective, "Committer name must match the pusher's GitLab name")
+	}
+	if rules.RejectUnsignedCommits {
+		effective = append(effective, "Unsigned commits are rejected")
+	}
+	if rules.RejectNonDCOCommits {
+		effective = append(effective, "Commits without a DCO sign-off are rejected")
+	}
+	if len(effective) == 0 {
+		effective = append(effective, "No push rules are enforced")
+	}
+
+	return pushRulesSummary{
+		ProjectPushRules: rules,
+		EffectiveRules:   effective,
+	}
+}
+
+// ListProtectedBranches returns a tool for listing the protected branch rules of a project
+func ListProtectedBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_protected_branches",
+		mcp.WithDescription(t("TOOL_LIST_PROTECTED_BRANCHES_DESCRIPTION", "List protected branch rules of a project with who can push, merge and unprotect")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		branches, _, err := client.ProtectedBranches.ListProtectedBranches(
+			fmt.Sprintf("%s/%s", namespace, project),
+			&gitlab.ListProtectedBranchesOptions{ListOptions: pagination},
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list protected branches: %w", err).Error()), nil
+		}
+
+		summaries := make([]protectedBranchSummary, 0, len(branches))
+		for _, branch := range branches {
+			summaries = append(summaries, summarizeProtectedBranch(branch))
+		}
+
+		jsonData, err := json.Marshal(summaries)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// GetProtectedBranch returns a tool for getting a single protected branch rule
+func GetProtectedBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_protected_branch",
+		mcp.WithDescription(t("TOOL_GET_PROTECTED_BRANCH_DESCRIPTION", "Get a protected branch rule with who can push, merge and unprotect")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("branch",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROTECTED_BRANCH_DESCRIPTION", "The name or wildcard of the protected branch")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		branchName, err := requiredParam[string](r, "branch")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		branch, _, err := client.ProtectedBranches.GetProtectedBranch(
+			fmt.Sprintf("%s/%s", namespace, project),
+			branchName,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get protected branch: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(summarizeProtectedBranch(branch))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// ListProtectedTags returns a tool for listing the protected tag rules of a project
+func ListProtectedTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_protected_tags",
+		mcp.WithDescription(t("TOOL_LIST_PROTECTED_TAGS_DESCRIPTION", "List protected tag rules of a project with who can create matching tags")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		tags, _, err := client.ProtectedTags.ListProtectedTags(
+			fmt.Sprintf("%s/%s", namespace, project),
+			(*gitlab.ListProtectedTagsOptions)(&pagination),
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list protected tags: %w", err).Error()), nil
+		}
+
+		summaries := make([]protectedTagSummary, 0, len(tags))
+		for _, tag := range tags {
+			summaries = append(summaries, summarizeProtectedTag(tag))
+		}
+
+		jsonData, err := json.Marshal(summaries)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// GetProtectedTag returns a tool for getting a single protected tag rule
+func GetProtectedTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_protected_tag",
+		mcp.WithDescription(t("TOOL_GET_PROTECTED_TAG_DESCRIPTION", "Get a protected tag rule with who can create matching tags")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("tag_name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROTECTED_TAG_DESCRIPTION", "The name or wildcard of the protected tag")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagName, err := requiredParam[string](r, "tag_name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		tag, _, err := client.ProtectedTags.GetProtectedTag(
+			fmt.Sprintf("%s/%s", namespace, project),
+			tagName,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get protected tag: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(summarizeProtectedTag(tag))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// GetPushRules returns a tool for getting the push rules of a project
+func GetPushRules(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_push_rules",
+		mcp.WithDescription(t("TOOL_GET_PUSH_RULES_DESCRIPTION", "Get the push rules of a project and which of them are enforced")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		rules, _, err := client.Projects.GetProjectPushRules(fmt.Sprintf("%s/%s", namespace, project))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get push rules: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(summarizePushRules(rules))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// ProtectBranch returns a tool for protecting a branch or branch wildcard
+func ProtectBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"protect_branch",
+		mcp.WithDescription(t("TOOL_PROTECT_BRANCH_DESCRIPTION", "Protect a branch or branch wildcard such as release/*")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("branch",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROTECTED_BRANCH_DESCRIPTION", "The name or wildcard of the protected branch")),
+		),
+		mcp.WithString("push_access_level",
+			mcp.Description(t("PARAM_PUSH_ACCESS_LEVEL_DESCRIPTION", "Who can push (default maintainer)")),
+			mcp.Enum("no_access", "developer", "maintainer", "admin"),
+		),
+		mcp.WithString("merge_access_level",
+			mcp.Description(t("PARAM_MERGE_ACCESS_LEVEL_DESCRIPTION", "Who can merge (default maintainer)")),
+			mcp.Enum("no_access", "developer", "maintainer", "admin"),
+		),
+		mcp.WithString("unprotect_access_level",
+			mcp.Description(t("PARAM_UNPROTECT_ACCESS_LEVEL_DESCRIPTION", "Who can unprotect (default maintainer)")),
+			mcp.Enum("developer", "maintainer", "admin"),
+		),
+		mcp.WithBoolean("allow_force_push",
+			mcp.Description(t("PARAM_ALLOW_FORCE_PUSH_DESCRIPTION", "Allow users who can push to force push")),
+		),
+		mcp.WithBoolean("code_owner_approval_required",
+			mcp.Description(t("PARAM_CODE_OWNER_APPROVAL_REQUIRED_DESCRIPTION", "Require code owner approval for changes to files with code owners")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		branchName, err := requiredParam[string](r, "branch")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.ProtectRepositoryBranchesOptions{Name: &branchName}
+		if opts.PushAccessLevel, err = optionalAccessLevel(r, "push_access_level"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.MergeAccessLevel, err = optionalAccessLevel(r, "merge_access_level"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.UnprotectAccessLevel, err = optionalAccessLevel(r, "unprotect_access_level"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.AllowForcePush, err = optionalPtrParam[bool](r, "allow_force_push"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.CodeOwnerApprovalRequired, err = optionalPtrParam[bool](r, "code_owner_approval_required"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		branch, _, err := client.ProtectedBranches.ProtectRepositoryBranches(
+			fmt.Sprintf("%s/%s", namespace, project),
+			opts,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to protect branch: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(summarizeProtectedBranch(branch))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// UnprotectBranch returns a tool for removing a protected branch rule
+func UnprotectBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"unprotect_branch",
+		mcp.WithDescription(t("TOOL_UNPROTECT_BRANCH_DESCRIPTION", "Remove a protected branch rule")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("branch",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROTECTED_BRANCH_DESCRIPTION", "The name or wildcard of the protected branch")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		branchName, err := requiredParam[string](r, "branch")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		_, err = client.ProtectedBranches.UnprotectRepositoryBranches(
+			fmt.Sprintf("%s/%s", namespace, project),
+			branchName,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to unprotect branch: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Branch %s unprotected", branchName)), nil
+	}
+
+	return tool, handler
+}
+
+// ProtectTag returns a tool for protecting a tag or tag wildcard
+func ProtectTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"protect_tag",
+		mcp.WithDescription(t("TOOL_PROTECT_TAG_DESCRIPTION", "Protect a tag or tag wildcard such as v*")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("tag_name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROTECTED_TAG_DESCRIPTION", "The name or wildcard of the protected tag")),
+		),
+		mcp.WithString("create_access_level",
+			mcp.Description(t("PARAM_CREATE_ACCESS_LEVEL_DESCRIPTION", "Who can create matching tags (default maintainer)")),
+			mcp.Enum("no_access", "developer", "maintainer", "admin"),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagName, err := requiredParam[string](r, "tag_name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.ProtectRepositoryTagsOptions{Name: &tagName}
+		if opts.CreateAccessLevel, err = optionalAccessLevel(r, "create_access_level"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		tag, _, err := client.ProtectedTags.ProtectRepositoryTags(
+			fmt.Sprintf("%s/%s", namespace, project),
+			opts,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to protect tag: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(summarizeProtectedTag(tag))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// UnprotectTag returns a tool for removing a protected tag rule
+func UnprotectTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"unprotect_tag",
+		mcp.WithDescription(t("TOOL_UNPROTECT_TAG_DESCRIPTION", "Remove a protected tag rule")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("tag_name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROTECTED_TAG_DESCRIPTION", "The name or wildcard of the protected tag")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagName, err := requiredParam[string](r, "tag_name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		_, err = client.ProtectedTags.UnprotectRepositoryTags(
+			fmt.Sprintf("%s/%s", namespace, project),
+			tagName,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to unprotect tag: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Tag %s unprotected", tagName)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,241 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockProtectedBranchesService is a mock implementation of the GitLab protected branches service
+type mockProtectedBranchesService struct {
+	listFunc    func(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error)
+	protectFunc func(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error)
+}
+
+// ensure mockProtectedBranchesService implements the gitlab.ProtectedBranchesServiceInterface
+var _ gitlab.ProtectedBranchesServiceInterface = &mockProtectedBranchesService{}
+
+func (m *mockProtectedBranchesService) ListProtectedBranches(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return m.listFunc(pid, opt, options...)
+}
+
+func (m *mockProtectedBranchesService) ProtectRepositoryBranches(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return m.protectFunc(pid, opt, options...)
+}
+
+func (m *mockProtectedBranchesService) GetProtectedBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockProtectedBranchesService) RequireCodeOwnerApprovals(pid interface{}, branch string, opt *gitlab.RequireCodeOwnerApprovalsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockProtectedBranchesService) UnprotectRepositoryBranches(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockProtectedBranchesService) UpdateProtectedBranch(pid interface{}, branch string, opt *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestSummarizeProtectedBranch(t *testing.T) {
+	branch := &gitlab.ProtectedBranch{
+		Name: "release/*",
+		PushAccessLevels: []*gitlab.BranchAccessDescription{
+			{AccessLevel: gitlab.MaintainerPermissions},
+		},
+		MergeAccessLevels: []*gitlab.BranchAccessDescription{
+			{AccessLevel: gitlab.DeveloperPermissions},
+			{UserID: 7, AccessLevelDescription: "Jane Doe"},
+		},
+		UnprotectAccessLevels:     []*gitlab.BranchAccessDescription{},
+		CodeOwnerApprovalRequired: true,
+	}
+
+	summary := summarizeProtectedBranch(branch)
+	assert.Equal(t,
+		`Branches matching "release/*" can be pushed to by maintainers and merged into by developers and maintainers, user Jane Doe. Force push is not allowed. Code owner approval is required for changes to files with code owners. Unprotecting is allowed for no one.`,
+		summary.EffectiveAccess,
+	)
+}
+
+func TestSummarizePushRules(t *testing.T) {
+	summary := summarizePushRules(&gitlab.ProjectPushRules{})
+	assert.Equal(t, []string{"No push rules are enforced"}, summary.EffectiveRules)
+
+	summary = summarizePushRules(&gitlab.ProjectPushRules{
+		BranchNameRegex: "^(feat|fix)/",
+		MaxFileSize:     10,
+		PreventSecrets:  true,
+	})
+	assert.Equal(t, []string{
+		`Branch names must match "^(feat|fix)/"`,
+		"Files larger than 10 MB cannot be pushed",
+		"Files that are likely to contain secrets are rejected",
+	}, summary.EffectiveRules)
+}
+
+func TestListProtectedBranches(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mockResponse  []*gitlab.ProtectedBranch
+		mockError     error
+		expectedError string
+	}{
+		{
+			name: "successful list",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+			},
+			mockResponse: []*gitlab.ProtectedBranch{
+				{
+					Name:              "main",
+					PushAccessLevels:  []*gitlab.BranchAccessDescription{{AccessLevel: gitlab.NoPermissions}},
+					MergeAccessLevels: []*gitlab.BranchAccessDescription{{AccessLevel: gitlab.MaintainerPermissions}},
+				},
+			},
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+			},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to list protected branches: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					ProtectedBranches: &mockProtectedBranchesService{
+						listFunc: func(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error) {
+							assert.Equal(t, "test-namespace/test-project", pid)
+							return tc.mockResponse, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListProtectedBranches(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got []protectedBranchSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			require.Len(t, got, 1)
+			assert.Equal(t, "main", got[0].Name)
+			assert.Contains(t, got[0].EffectiveAccess, "pushed to by no one and merged into by maintainers")
+		})
+	}
+}
+
+func TestProtectBranch(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedPush  *gitlab.AccessLevelValue
+		expectedMerge *gitlab.AccessLevelValue
+		expectedError string
+	}{
+		{
+			name: "access levels by name",
+			args: map[string]interface{}{
+				"namespace":          "test-namespace",
+				"project":            "test-project",
+				"branch":             "main",
+				"push_access_level":  "no_access",
+				"merge_access_level": "developer",
+			},
+			expectedPush:  gitlab.Ptr(gitlab.NoPermissions),
+			expectedMerge: gitlab.Ptr(gitlab.DeveloperPermissions),
+		},
+		{
+			name: "defaults left to GitLab",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"branch":    "main",
+			},
+		},
+		{
+			name: "invalid access level",
+			args: map[string]interface{}{
+				"namespace":         "test-namespace",
+				"project":           "test-project",
+				"branch":            "main",
+				"push_access_level": "guest",
+			},
+			expectedError: "parameter push_access_level must be one of no_access, developer, maintainer or admin, got guest",
+		},
+		{
+			name: "allow_force_push of the wrong type",
+			args: map[string]interface{}{
+				"namespace":        "test-namespace",
+				"project":          "test-project",
+				"branch":           "main",
+				"allow_force_push": "true",
+			},
+			expectedError: "parameter allow_force_push is not of type bool",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					ProtectedBranches: &mockProtectedBranchesService{
+						protectFunc: func(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+							assert.Equal(t, "main", *opt.Name)
+							assert.Equal(t, tc.expectedPush, opt.PushAccessLevel)
+							assert.Equal(t, tc.expectedMerge, opt.MergeAccessLevel)
+							return &gitlab.ProtectedBranch{Name: *opt.Name}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusCreated}}, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ProtectBranch(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Contains(t, textContent.Text, `"effective_access"`)
+		})
+	}
+}
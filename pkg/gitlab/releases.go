@@ -0,0 +1,464 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// releaseAssetLinkSchema is the JSON schema of a single entry of the asset_links parameter
+var releaseAssetLinkSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"name": map[string]interface{}{
+			"type":        "string",
+			"description": "The name of the link",
+		},
+		"url": map[string]interface{}{
+			"type":        "string",
+			"description": "The URL of the asset",
+		},
+		"filepath": map[string]interface{}{
+			"type":        "string",
+			"description": "Optional path for a direct asset link, e.g. /binaries/linux-amd64",
+		},
+		"link_type": map[string]interface{}{
+			"type":        "string",
+			"description": "The type of the link",
+			"enum":        []string{"other", "runbook", "image", "package"},
+		},
+	},
+	"required": []string{"name", "url"},
+}
+
+// withReleaseAssetLinks adds the optional asset_links parameter to a tool
+func withReleaseAssetLinks(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return mcp.WithArray("asset_links",
+		mcp.Description(t("PARAM_RELEASE_ASSET_LINKS_DESCRIPTION", "Asset links to attach to the release")),
+		mcp.Items(releaseAssetLinkSchema),
+	)
+}
+
+// optionalReleaseAssetLinks parses the asset_links parameter from the request
+func optionalReleaseAssetLinks(r mcp.CallToolRequest) ([]*gitlab.CreateReleaseLinkOptions, error) {
+	raw, ok := r.Params.Arguments["asset_links"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("parameter asset_links is not an array, is %T", raw)
+	}
+
+	links := make([]*gitlab.CreateReleaseLinkOptions, 0, len(items))
+	for i, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("asset_links[%d] is not an object", i)
+		}
+		values := make(map[string]string)
+		for _, key := range []string{"name", "url", "filepath", "link_type"} {
+			if v, ok := fields[key]; ok && v != nil {
+				s, ok := v.(string)
+				if !ok {
+					return nil, fmt.Errorf("asset_links[%d].%s is not of type string, is %T", i, key, v)
+				}
+				values[key] = s
+			}
+		}
+		if values["name"] == "" || values["url"] == "" {
+			return nil, fmt.Errorf("asset_links[%d] requires a name and url", i)
+		}
+
+		link := &gitlab.CreateReleaseLinkOptions{
+			Name: gitlab.Ptr(values["name"]),
+			URL:  gitlab.Ptr(values["url"]),
+		}
+		if filePath := values["filepath"]; filePath != "" {
+			link.DirectAssetPath = gitlab.Ptr(filePath)
+		}
+		if linkType := values["link_type"]; linkType != "" {
+			link.LinkType = gitlab.Ptr(gitlab.LinkTypeValue(linkType))
+		}
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+// addReleaseAssetLinks attaches the given asset links to an existing release
+func addReleaseAssetLinks(client *gitlab.Client, pid string, tagName string, links []*gitlab.CreateReleaseLinkOptions) error {
+	for _, link := range links {
+		if _, _, err := client.ReleaseLinks.CreateReleaseLink(pid, tagName, link); err != nil {
+			return fmt.Errorf("failed to add asset link %s: %w", *link.Name, err)
+		}
+	}
+	return nil
+}
+
+// ListReleases returns a tool for listing releases in a project
+func ListReleases(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_releases",
+		mcp.WithDescription(t("TOOL_LIST_RELEASES_DESCRIPTION", "List releases in a project, newest first")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		releases, _, err := client.Releases.ListReleases(
+			fmt.Sprintf("%s/%s", namespace, project),
+			&gitlab.ListReleasesOptions{ListOptions: pagination},
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list releases: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(releases)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// GetRelease returns a tool for getting a single release
+func GetRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_release",
+		mcp.WithDescription(t("TOOL_GET_RELEASE_DESCRIPTION", "Get a specific release by its tag name")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("tag_name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_RELEASE_TAG_NAME_DESCRIPTION", "The tag the release is associated with")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagName, err := requiredParam[string](r, "tag_name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		release, _, err := client.Releases.GetRelease(
+			fmt.Sprintf("%s/%s", namespace, project),
+			tagName,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get release: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(release)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// CreateRelease returns a tool for creating a new release
+func CreateRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"create_release",
+		mcp.WithDescription(t("TOOL_CREATE_RELEASE_DESCRIPTION", "Create a new release. The tag is created from ref if it does not exist yet")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("tag_name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_RELEASE_TAG_NAME_DESCRIPTION", "The tag the release is associated with")),
+		),
+		mcp.WithString("name",
+			mcp.Description(t("PARAM_RELEASE_NAME_DESCRIPTION", "The name of the release")),
+		),
+		mcp.WithString("description",
+			mcp.Description(t("PARAM_RELEASE_DESCRIPTION_DESCRIPTION", "The release notes in Markdown")),
+		),
+		mcp.WithString("ref",
+			mcp.Description(t("PARAM_RELEASE_REF_DESCRIPTION", "The branch or commit SHA to create the tag from when it does not exist")),
+		),
+		mcp.WithString("tag_message",
+			mcp.Description(t("PARAM_RELEASE_TAG_MESSAGE_DESCRIPTION", "Message for the annotated tag created along with the release")),
+		),
+		mcp.WithArray("milestones",
+			mcp.Description(t("PARAM_RELEASE_MILESTONES_DESCRIPTION", "Titles of the milestones the release is associated with")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		),
+		mcp.WithString("released_at",
+			mcp.Description(t("PARAM_RELEASED_AT_DESCRIPTION", "The date the release is ready (YYYY-MM-DD or RFC 3339), defaults to now")),
+		),
+		withReleaseAssetLinks(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagName, err := requiredParam[string](r, "tag_name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		milestones, err := OptionalStringArrayParam(r, "milestones")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		links, err := optionalReleaseAssetLinks(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		name, err := OptionalParam[string](r, "name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		description, err := OptionalParam[string](r, "description")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref, err := OptionalParam[string](r, "ref")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagMessage, err := OptionalParam[string](r, "tag_message")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.CreateReleaseOptions{TagName: &tagName}
+		if opts.ReleasedAt, err = OptionalTime(r, "released_at"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if name != "" {
+			opts.Name = &name
+		}
+		if description != "" {
+			opts.Description = &description
+		}
+		if ref != "" {
+			opts.Ref = &ref
+		}
+		if tagMessage != "" {
+			opts.TagMessage = &tagMessage
+		}
+		if len(milestones) > 0 {
+			opts.Milestones = &milestones
+		}
+
+		projectID := fmt.Sprintf("%s/%s", namespace, project)
+
+		release, _, err := client.Releases.CreateRelease(projectID, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to create release: %w", err).Error()), nil
+		}
+
+		if len(links) > 0 {
+			if err := addReleaseAssetLinks(client, projectID, tagName, links); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("release %s created, but %v", tagName, err)), nil
+			}
+			// Reload the release so the response includes the new links
+			if release, _, err = client.Releases.GetRelease(projectID, tagName); err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to get release: %w", err).Error()), nil
+			}
+		}
+
+		jsonData, err := json.Marshal(release)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// UpdateRelease returns a tool for updating an existing release
+func UpdateRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"update_release",
+		mcp.WithDescription(t("TOOL_UPDATE_RELEASE_DESCRIPTION", "Update a release and optionally attach additional asset links")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("tag_name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_RELEASE_TAG_NAME_DESCRIPTION", "The tag the release is associated with")),
+		),
+		mcp.WithString("name",
+			mcp.Description(t("PARAM_RELEASE_NAME_DESCRIPTION", "The new name of the release")),
+		),
+		mcp.WithString("description",
+			mcp.Description(t("PARAM_RELEASE_DESCRIPTION_DESCRIPTION", "The new release notes in Markdown")),
+		),
+		mcp.WithArray("milestones",
+			mcp.Description(t("PARAM_RELEASE_MILESTONES_DESCRIPTION", "Titles of the milestones the release is associated with")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		),
+		mcp.WithString("released_at",
+			mcp.Description(t("PARAM_RELEASED_AT_DESCRIPTION", "The date the release is ready (YYYY-MM-DD or RFC 3339)")),
+		),
+		withReleaseAssetLinks(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagName, err := requiredParam[string](r, "tag_name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		milestones, err := OptionalStringArrayParam(r, "milestones")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		links, err := optionalReleaseAssetLinks(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		name, err := OptionalParam[string](r, "name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		description, err := OptionalParam[string](r, "description")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		projectID := fmt.Sprintf("%s/%s", namespace, project)
+
+		// The update endpoint always sends name and description, so start from the current
+		// values to avoid clearing fields the caller did not ask to change.
+		release, _, err := client.Releases.GetRelease(projectID, tagName)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get release: %w", err).Error()), nil
+		}
+
+		opts := &gitlab.UpdateReleaseOptions{
+			Name:        gitlab.Ptr(release.Name),
+			Description: gitlab.Ptr(release.Description),
+		}
+		if opts.ReleasedAt, err = OptionalTime(r, "released_at"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if name != "" {
+			opts.Name = &name
+		}
+		if description != "" {
+			opts.Description = &description
+		}
+		if milestones != nil {
+			opts.Milestones = &milestones
+		}
+
+		release, _, err = client.Releases.UpdateRelease(projectID, tagName, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to update release: %w", err).Error()), nil
+		}
+
+		if len(links) > 0 {
+			if err := addReleaseAssetLinks(client, projectID, tagName, links); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("release %s updated, but %v", tagName, err)), nil
+			}
+			if release, _, err = client.Releases.GetRelease(projectID, tagName); err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to get release: %w", err).Error()), nil
+			}
+		}
+
+		jsonData, err := json.Marshal(release)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,289 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockReleasesService is a mock implementation of the GitLab releases service
+type mockReleasesService struct {
+	getFunc    func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	createFunc func(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	updateFunc func(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+}
+
+// ensure mockReleasesService implements the gitlab.ReleasesServiceInterface
+var _ gitlab.ReleasesServiceInterface = &mockReleasesService{}
+
+func (m *mockReleasesService) GetRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return m.getFunc(pid, tagName, options...)
+}
+
+func (m *mockReleasesService) CreateRelease(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return m.createFunc(pid, opts, options...)
+}
+
+func (m *mockReleasesService) UpdateRelease(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return m.updateFunc(pid, tagName, opts, options...)
+}
+
+func (m *mockReleasesService) DeleteRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockReleasesService) GetLatestRelease(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockReleasesService) ListReleases(pid interface{}, opt *gitlab.ListReleasesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Release, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+// mockReleaseLinksService is a mock implementation of the GitLab release links service
+type mockReleaseLinksService struct {
+	createFunc func(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+}
+
+// ensure mockReleaseLinksService implements the gitlab.ReleaseLinksServiceInterface
+var _ gitlab.ReleaseLinksServiceInterface = &mockReleaseLinksService{}
+
+func (m *mockReleaseLinksService) CreateReleaseLink(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return m.createFunc(pid, tagName, opt, options...)
+}
+
+func (m *mockReleaseLinksService) DeleteReleaseLink(pid interface{}, tagName string, link int, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockReleaseLinksService) GetReleaseLink(pid interface{}, tagName string, link int, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockReleaseLinksService) ListReleaseLinks(pid interface{}, tagName string, opt *gitlab.ListReleaseLinksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockReleaseLinksService) UpdateReleaseLink(pid interface{}, tagName string, link int, opt *gitlab.UpdateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestOptionalReleaseAssetLinks(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedLinks int
+		expectedError string
+	}{
+		{
+			name:          "no links",
+			args:          map[string]interface{}{},
+			expectedLinks: 0,
+		},
+		{
+			name: "valid links",
+			args: map[string]interface{}{
+				"asset_links": []interface{}{
+					map[string]interface{}{"name": "linux", "url": "https://example.com/linux", "link_type": "package", "filepath": "/bin/linux"},
+					map[string]interface{}{"name": "docs", "url": "https://example.com/docs"},
+				},
+			},
+			expectedLinks: 2,
+		},
+		{
+			name: "missing url",
+			args: map[string]interface{}{
+				"asset_links": []interface{}{
+					map[string]interface{}{"name": "linux"},
+				},
+			},
+			expectedError: "asset_links[0] requires a name and url",
+		},
+		{
+			name: "not an array",
+			args: map[string]interface{}{
+				"asset_links": "https://example.com",
+			},
+			expectedError: "parameter asset_links is not an array",
+		},
+		{
+			name: "link type given as a number",
+			args: map[string]interface{}{
+				"asset_links": []interface{}{
+					map[string]interface{}{"name": "linux", "url": "https://example.com/linux", "link_type": float64(1)},
+				},
+			},
+			expectedError: "asset_links[0].link_type is not of type string, is float64",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			links, err := optionalReleaseAssetLinks(createMCPRequest(tc.args))
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, links, tc.expectedLinks)
+			if tc.expectedLinks == 2 {
+				assert.Equal(t, gitlab.PackageLinkType, *links[0].LinkType)
+				assert.Equal(t, "/bin/linux", *links[0].DirectAssetPath)
+				assert.Nil(t, links[1].LinkType)
+			}
+		})
+	}
+}
+
+func TestCreateRelease(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		linkError     error
+		expectedLinks int
+		expectedError string
+	}{
+		{
+			name: "release with asset links",
+			args: map[string]interface{}{
+				"namespace":  "test-namespace",
+				"project":    "test-project",
+				"tag_name":   "v1.0.0",
+				"name":       "Version 1.0.0",
+				"milestones": []interface{}{"1.0"},
+				"asset_links": []interface{}{
+					map[string]interface{}{"name": "linux", "url": "https://example.com/linux"},
+				},
+			},
+			expectedLinks: 1,
+		},
+		{
+			name: "asset link failure is reported",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"tag_name":  "v1.0.0",
+				"asset_links": []interface{}{
+					map[string]interface{}{"name": "linux", "url": "https://example.com/linux"},
+				},
+			},
+			linkError:     fmt.Errorf("API error"),
+			expectedError: "release v1.0.0 created, but failed to add asset link linux: API error",
+		},
+		{
+			name: "missing tag name",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+			},
+			expectedError: "missing required parameter: tag_name",
+		},
+		{
+			name: "tag message given as a boolean",
+			args: map[string]interface{}{
+				"namespace":   "test-namespace",
+				"project":     "test-project",
+				"tag_name":    "v1.0.0",
+				"tag_message": true,
+			},
+			expectedError: "parameter tag_message is not of type string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var links []*gitlab.ReleaseLink
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Releases: &mockReleasesService{
+						createFunc: func(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+							if tc.args["milestones"] != nil {
+								assert.Equal(t, []string{"1.0"}, *opts.Milestones)
+							}
+							return &gitlab.Release{TagName: *opts.TagName}, nil, nil
+						},
+						getFunc: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+							release := &gitlab.Release{TagName: tagName}
+							release.Assets.Links = links
+							return release, nil, nil
+						},
+					},
+					ReleaseLinks: &mockReleaseLinksService{
+						createFunc: func(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+							if tc.linkError != nil {
+								return nil, nil, tc.linkError
+							}
+							link := &gitlab.ReleaseLink{Name: *opt.Name, URL: *opt.URL}
+							links = append(links, link)
+							return link, nil, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := CreateRelease(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var release gitlab.Release
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &release))
+			assert.Equal(t, "v1.0.0", release.TagName)
+			assert.Len(t, release.Assets.Links, tc.expectedLinks)
+		})
+	}
+}
+
+func TestUpdateReleaseKeepsUnchangedFields(t *testing.T) {
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return &gitlab.Client{
+			Releases: &mockReleasesService{
+				getFunc: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+					return &gitlab.Release{TagName: tagName, Name: "Version 1.0.0", Description: "Old notes"}, nil, nil
+				},
+				updateFunc: func(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+					return &gitlab.Release{TagName: tagName, Name: *opts.Name, Description: *opts.Description}, nil, nil
+				},
+			},
+		}, nil
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := UpdateRelease(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"namespace":   "test-namespace",
+		"project":     "test-project",
+		"tag_name":    "v1.0.0",
+		"description": "New notes",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var release gitlab.Release
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &release))
+	assert.Equal(t, "Version 1.0.0", release.Name)
+	assert.Equal(t, "New notes", release.Description)
+}
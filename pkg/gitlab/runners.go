@@ -0,0 +1,359 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// runnerSummary is a runner with what is needed to tell whether it can pick up a job. Tokens are left out.
+type runnerSummary struct {
+	ID          int        `json:"id"`
+	Description string     `json:"description"`
+	Type        string     `json:"type"`
+	Status      string     `json:"status"`
+	Online      bool       `json:"online"`
+	Paused      bool       `json:"paused"`
+	Tags        []string   `json:"tags"`
+	RunUntagged bool       `json:"run_untagged"`
+	Locked      bool       `json:"locked"`
+	ContactedAt *time.Time `json:"contacted_at,omitempty"`
+	Version     string     `json:"version,omitempty"`
+	Platform    string     `json:"platform,omitempty"`
+}
+
+// summarizeRunner flattens the details of a runner
+func summarizeRunner(runner *gitlab.RunnerDetails) runnerSummary {
+	summary := runnerSummary{
+		ID:          runner.ID,
+		Description: runner.Description,
+		Type:        runner.RunnerType,
+		Status:      runner.Status,
+		Online:      runner.Online,
+		Paused:      runner.Paused,
+		Tags:        runner.TagList,
+		RunUntagged: runner.RunUntagged,
+		Locked:      runner.Locked,
+		ContactedAt: runner.ContactedAt,
+		Version:     runner.Version,
+		Platform:    runner.Platform,
+	}
+	if summary.Tags == nil {
+		summary.Tags = []string{}
+	}
+	return summary
+}
+
+// runnerFilters are the filters of list_runners, nil when not given
+type runnerFilters struct {
+	runnerType *string
+	status     *string
+	paused     *bool
+	tags       *[]string
+}
+
+// listGroupRunnersByPaused lists one page of the runners of a group that are paused or not.
+// The group endpoint has no paused filter, so up to maxListPages pages are read and filtered before paginating.
+func listGroupRunnersByPaused(client *gitlab.Client, path string, filters runnerFilters, pagination gitlab.ListOptions) ([]*gitlab.Runner, error) {
+	runners, _, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.Runner, *gitlab.Response, error) {
+		return client.Runners.ListGroupsRunners(path, &gitlab.ListGroupsRunnersOptions{
+			ListOptions: opts,
+			Type:        filters.runnerType,
+			Status:      filters.status,
+			TagList:     filters.tags,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make([]*gitlab.Runner, 0, len(runners))
+	for _, runner := range runners {
+		if runner.Paused == *filters.paused {
+			matching = append(matching, runner)
+		}
+	}
+	return listPage(matching, pagination), nil
+}
+
+// listRunnersWithDetails lists the runners of a project, a group or the instance and gets the details of each one,
+// as the list endpoints do not include tags or when the runner last contacted GitLab. That is one request per runner.
+func listRunnersWithDetails(client *gitlab.Client, level string, path string, filters runnerFilters, pagination gitlab.ListOptions) ([]*gitlab.RunnerDetails, error) {
+	var runners []*gitlab.Runner
+	var err error
+	switch {
+	case level == "project":
+		runners, _, err = client.Runners.ListProjectRunners(path, &gitlab.ListProjectRunnersOptions{
+			ListOptions: pagination,
+			Type:        filters.runnerType,
+			Status:      filters.status,
+			Paused:      filters.paused,
+			TagList:     filters.tags,
+		})
+	case level == "group" && filters.paused != nil:
+		runners, err = listGroupRunnersByPaused(client, path, filters, pagination)
+	case level == "group":
+		runners, _, err = client.Runners.ListGroupsRunners(path, &gitlab.ListGroupsRunnersOptions{
+			ListOptions: pagination,
+			Type:        filters.runnerType,
+			Status:      filters.status,
+			TagList:     filters.tags,
+		})
+	default:
+		runners, _, err = client.Runners.ListAllRunners(&gitlab.ListRunnersOptions{
+			ListOptions: pagination,
+			Type:        filters.runnerType,
+			Status:      filters.status,
+			Paused:      filters.paused,
+			TagList:     filters.tags,
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list runners: %w", err)
+	}
+
+	detailed := make([]*gitlab.RunnerDetails, 0, len(runners))
+	for _, runner := range runners {
+		details, _, err := client.Runners.GetRunnerDetails(runner.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get runner %d: %w", runner.ID, err)
+		}
+		detailed = append(detailed, details)
+	}
+	return detailed, nil
+}
+
+// ListRunners returns a tool for listing the runners of a project, a group or the instance
+func ListRunners(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_runners",
+		mcp.WithDescription(t("TOOL_LIST_RUNNERS_DESCRIPTION", "List the runners available to a project, a group or the instance with their tags, status, paused state and when they last contacted GitLab. Use it to find out why jobs stay pending. The details of each runner are fetched separately, so keep per_page small")),
+		mcp.WithString("level",
+			mcp.Description(t("PARAM_RUNNER_LEVEL_DESCRIPTION", "Whether to list the runners of a project, a group or the instance. Defaults to project when project is given, group when only namespace is given and instance otherwise. Instance runners need administrator access")),
+			mcp.Enum("project", "group", "instance"),
+		),
+		mcp.WithString("namespace",
+			mcp.Description(t("PARAM_RUNNER_NAMESPACE_DESCRIPTION", "The namespace of the project, or the group")),
+		),
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_RUNNER_PROJECT_DESCRIPTION", "The name of the project. Requires namespace")),
+		),
+		mcp.WithString("type",
+			mcp.Description(t("PARAM_RUNNER_TYPE_DESCRIPTION", "Only return runners of this type")),
+			mcp.Enum("instance_type", "group_type", "project_type"),
+		),
+		mcp.WithString("status",
+			mcp.Description(t("PARAM_RUNNER_STATUS_DESCRIPTION", "Only return runners with this status")),
+			mcp.Enum("online", "offline", "stale", "never_contacted"),
+		),
+		mcp.WithBoolean("paused",
+			mcp.Description(t("PARAM_RUNNER_PAUSED_DESCRIPTION", "Only return paused runners when true, or runners accepting jobs when false. For groups this is filtered over the first 1000 runners, as GitLab has no such filter for groups")),
+		),
+		mcp.WithString("tags",
+			mcp.Description(t("PARAM_RUNNER_TAGS_DESCRIPTION", "Comma-separated tags the runners must all have, e.g. the tags of a pending job")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		target, err := readLevelTarget(r, "runners", true)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		var filters runnerFilters
+		for name, filter := range map[string]**string{"type": &filters.runnerType, "status": &filters.status} {
+			value, err := OptionalParam[string](r, name)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if value != "" {
+				*filter = gitlab.Ptr(value)
+			}
+		}
+		if filters.paused, err = optionalPtrParam[bool](r, "paused"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tags, err := OptionalParam[string](r, "tags")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if tags != "" {
+			var tagList []string
+			for _, tag := range strings.Split(tags, ",") {
+				if tag = strings.TrimSpace(tag); tag != "" {
+					tagList = append(tagList, tag)
+				}
+			}
+			filters.tags = &tagList
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		runners, err := listRunnersWithDetails(client, target.level, target.path, filters, pagination)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		summaries := make([]runnerSummary, 0, len(runners))
+		for _, runner := range runners {
+			summaries = append(summaries, summarizeRunner(runner))
+		}
+
+		jsonData, err := json.Marshal(summaries)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// runnerJob is a job run by a runner, with the project it belongs to
+type runnerJob struct {
+	jobSummary
+	Project string `json:"project,omitempty"`
+}
+
+// ListRunnerJobs returns a tool for listing the jobs run by a runner
+func ListRunnerJobs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_runner_jobs",
+		mcp.WithDescription(t("TOOL_LIST_RUNNER_JOBS_DESCRIPTION", "List the jobs run by a runner, newest first")),
+		mcp.WithNumber("runner_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_RUNNER_ID_DESCRIPTION", "The ID of the runner, as returned by list_runners")),
+		),
+		mcp.WithString("status",
+			mcp.Description(t("PARAM_RUNNER_JOB_STATUS_DESCRIPTION", "Only return jobs with this status")),
+			mcp.Enum("running", "success", "failed", "canceled"),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		runnerID, err := RequiredInt(r, "runner_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		status, err := OptionalParam[string](r, "status")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.ListRunnerJobsOptions{
+			ListOptions: pagination,
+			OrderBy:     gitlab.Ptr("id"),
+			Sort:        gitlab.Ptr("desc"),
+		}
+		if status != "" {
+			opts.Status = gitlab.Ptr(status)
+		}
+
+		jobs, _, err := client.Runners.ListRunnerJobs(runnerID, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list runner jobs: %w", err).Error()), nil
+		}
+
+		result := make([]runnerJob, 0, len(jobs))
+		for _, job := range jobs {
+			j := runnerJob{jobSummary: summarizeJob(job)}
+			if job.Project != nil {
+				j.Project = job.Project.PathWithNamespace
+			}
+			result = append(result, j)
+		}
+
+		jsonData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// setRunnerPaused returns the handler of pause_runner and resume_runner
+func setRunnerPaused(getClient GetClientFn, paused bool) server.ToolHandlerFunc {
+	return func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		runnerID, err := RequiredInt(r, "runner_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		runner, _, err := client.Runners.UpdateRunnerDetails(runnerID, &gitlab.UpdateRunnerDetailsOptions{Paused: gitlab.Ptr(paused)})
+		if err != nil {
+			action := "resume"
+			if paused {
+				action = "pause"
+			}
+			return mcp.NewToolResultError(fmt.Errorf("failed to %s runner: %w", action, err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(summarizeRunner(runner))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+}
+
+// PauseRunner returns a tool for pausing a runner so it stops picking up jobs
+func PauseRunner(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"pause_runner",
+		mcp.WithDescription(t("TOOL_PAUSE_RUNNER_DESCRIPTION", "Pause a runner so it stops picking up new jobs. Running jobs are not affected")),
+		mcp.WithNumber("runner_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_RUNNER_ID_DESCRIPTION", "The ID of the runner, as returned by list_runners")),
+		),
+	)
+
+	return tool, setRunnerPaused(getClient, true)
+}
+
+// ResumeRunner returns a tool for resuming a paused runner
+func ResumeRunner(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"resume_runner",
+		mcp.WithDescription(t("TOOL_RESUME_RUNNER_DESCRIPTION", "Resume a paused runner so it picks up jobs again")),
+		mcp.WithNumber("runner_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_RUNNER_ID_DESCRIPTION", "The ID of the runner, as returned by list_runners")),
+		),
+	)
+
+	return tool, setRunnerPaused(getClient, false)
+}
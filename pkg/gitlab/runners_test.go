@@ -0,0 +1,326 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockRunnersService is a mock implementation of the GitLab runners service
+type mockRunnersService struct {
+	listProjectFunc func(pid interface{}, opt *gitlab.ListProjectRunnersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Runner, *gitlab.Response, error)
+	listGroupFunc   func(gid interface{}, opt *gitlab.ListGroupsRunnersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Runner, *gitlab.Response, error)
+	getDetailsFunc  func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error)
+	updateFunc      func(rid interface{}, opt *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error)
+	listJobsFunc    func(rid interface{}, opt *gitlab.ListRunnerJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error)
+}
+
+// ensure mockRunnersService implements the gitlab.RunnersServiceInterface
+var _ gitlab.RunnersServiceInterface = &mockRunnersService{}
+
+func (m *mockRunnersService) ListProjectRunners(pid interface{}, opt *gitlab.ListProjectRunnersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Runner, *gitlab.Response, error) {
+	return m.listProjectFunc(pid, opt, options...)
+}
+
+func (m *mockRunnersService) GetRunnerDetails(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+	return m.getDetailsFunc(rid, options...)
+}
+
+func (m *mockRunnersService) UpdateRunnerDetails(rid interface{}, opt *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+	return m.updateFunc(rid, opt, options...)
+}
+
+func (m *mockRunnersService) ListRunnerJobs(rid interface{}, opt *gitlab.ListRunnerJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+	return m.listJobsFunc(rid, opt, options...)
+}
+
+func (m *mockRunnersService) DeleteRegisteredRunner(opt *gitlab.DeleteRegisteredRunnerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockRunnersService) DeleteRegisteredRunnerByID(rid int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockRunnersService) DisableProjectRunner(pid interface{}, runner int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockRunnersService) EnableProjectRunner(pid interface{}, opt *gitlab.EnableProjectRunnerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Runner, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRunnersService) ListAllRunners(opt *gitlab.ListRunnersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Runner, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRunnersService) ListGroupsRunners(gid interface{}, opt *gitlab.ListGroupsRunnersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Runner, *gitlab.Response, error) {
+	return m.listGroupFunc(gid, opt, options...)
+}
+
+func (m *mockRunnersService) ListRunners(opt *gitlab.ListRunnersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Runner, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRunnersService) RegisterNewRunner(opt *gitlab.RegisterNewRunnerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Runner, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRunnersService) RemoveRunner(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockRunnersService) ResetGroupRunnerRegistrationToken(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerRegistrationToken, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRunnersService) ResetInstanceRunnerRegistrationToken(options ...gitlab.RequestOptionFunc) (*gitlab.RunnerRegistrationToken, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRunnersService) ResetProjectRunnerRegistrationToken(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerRegistrationToken, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRunnersService) ResetRunnerAuthenticationToken(rid int, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerAuthenticationToken, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockRunnersService) VerifyRegisteredRunner(opt *gitlab.VerifyRegisteredRunnerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func TestListRunners(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+	contacted := time.Date(2024, 5, 6, 10, 0, 0, 0, time.UTC)
+
+	details := map[int]*gitlab.RunnerDetails{
+		1: {ID: 1, Description: "docker", RunnerType: "instance_type", Status: "online", Online: true, TagList: []string{"docker", "linux"}, ContactedAt: &contacted, Version: "17.0.0"},
+		2: {ID: 2, Description: "macos", RunnerType: "project_type", Status: "offline", Paused: true, TagList: []string{"docker", "macos"}, ContactedAt: &contacted},
+		3: {ID: 3, Description: "arm", RunnerType: "group_type", Status: "online", Online: true, TagList: []string{"arm"}, ContactedAt: &contacted},
+	}
+	runners := []*gitlab.Runner{{ID: 1}, {ID: 2, Paused: true}, {ID: 3}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedTags  *[]string
+		expected      []runnerSummary
+		expectedError string
+	}{
+		{
+			name: "project runners with tags",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"tags":      "docker, ",
+			},
+			expectedTags: &[]string{"docker"},
+			expected: []runnerSummary{
+				{ID: 1, Description: "docker", Type: "instance_type", Status: "online", Online: true, Tags: []string{"docker", "linux"}, ContactedAt: &contacted, Version: "17.0.0"},
+				{ID: 2, Description: "macos", Type: "project_type", Status: "offline", Paused: true, Tags: []string{"docker", "macos"}, ContactedAt: &contacted},
+			},
+		},
+		{
+			name: "runners accepting jobs",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"paused":    false,
+			},
+			expected: []runnerSummary{
+				{ID: 1, Description: "docker", Type: "instance_type", Status: "online", Online: true, Tags: []string{"docker", "linux"}, ContactedAt: &contacted, Version: "17.0.0"},
+			},
+		},
+		{
+			name: "second page of group runners accepting jobs",
+			args: map[string]interface{}{
+				"level":     "group",
+				"namespace": "group",
+				"paused":    false,
+				"page":      float64(2),
+				"per_page":  float64(1),
+			},
+			expected: []runnerSummary{
+				{ID: 3, Description: "arm", Type: "group_type", Status: "online", Online: true, Tags: []string{"arm"}, ContactedAt: &contacted},
+			},
+		},
+		{
+			name: "paused given as a string",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"paused":    "false",
+			},
+			expectedError: "parameter paused is not of type bool, is string",
+		},
+		{
+			name: "project level without namespace",
+			args: map[string]interface{}{
+				"level": "project",
+			},
+			expectedError: "namespace and project are required for project runners",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Runners: &mockRunnersService{
+						listProjectFunc: func(pid interface{}, opts *gitlab.ListProjectRunnersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Runner, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, tc.expectedTags, opts.TagList)
+							var listed []*gitlab.Runner
+							for _, runner := range runners[:2] {
+								if opts.Paused == nil || runner.Paused == *opts.Paused {
+									listed = append(listed, runner)
+								}
+							}
+							return listed, ok, nil
+						},
+						listGroupFunc: func(gid interface{}, opts *gitlab.ListGroupsRunnersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Runner, *gitlab.Response, error) {
+							assert.Equal(t, "group", gid)
+							// two runners per page, as GitLab has no paused filter for groups
+							if opts.Page == 0 {
+								return runners[:2], &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}, NextPage: 2}, nil
+							}
+							return runners[2:], ok, nil
+						},
+						getDetailsFunc: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+							runner, found := details[rid.(int)]
+							if !found {
+								return nil, nil, fmt.Errorf("runner %v not found", rid)
+							}
+							return runner, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListRunners(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got []runnerSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestListRunnerJobs(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return &gitlab.Client{
+			Runners: &mockRunnersService{
+				listJobsFunc: func(rid interface{}, opts *gitlab.ListRunnerJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+					assert.Equal(t, 3, rid)
+					assert.Equal(t, "running", *opts.Status)
+					job := &gitlab.Job{ID: 9, Name: "build", Stage: "build", Status: "running", Ref: "main", Project: &gitlab.Project{PathWithNamespace: "group/project"}}
+					job.Pipeline.ID = 42
+					return []*gitlab.Job{job}, ok, nil
+				},
+			},
+		}, nil
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := ListRunnerJobs(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"runner_id": float64(3),
+		"status":    "running",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var got []runnerJob
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.Equal(t, []runnerJob{{
+		jobSummary: jobSummary{ID: 9, Name: "build", Stage: "build", Status: "running", Ref: "main", PipelineID: 42},
+		Project:    "group/project",
+	}}, got)
+}
+
+func TestPauseAndResumeRunner(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		tool          func(GetClientFn, translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc)
+		updateError   error
+		expected      bool
+		expectedError string
+	}{
+		{name: "pause", tool: PauseRunner, expected: true},
+		{name: "resume", tool: ResumeRunner, expected: false},
+		{name: "pause fails", tool: PauseRunner, updateError: fmt.Errorf("403 Forbidden"), expectedError: "failed to pause runner: 403 Forbidden"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Runners: &mockRunnersService{
+						updateFunc: func(rid interface{}, opts *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+							assert.Equal(t, 3, rid)
+							if tc.updateError != nil {
+								return nil, nil, tc.updateError
+							}
+							return &gitlab.RunnerDetails{ID: 3, Paused: *opts.Paused}, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := tc.tool(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{"runner_id": float64(3)}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got runnerSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got.Paused)
+		})
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -145,3 +148,424 @@ func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 
 	return tool, handler
 }
+
+// searchTarget is where a scoped search runs
+type searchTarget struct {
+	// scope is project, group or global
+	scope string
+	// path is the project or group path, empty for global searches
+	path string
+}
+
+// withSearchScope adds the query, scope and pagination parameters of the scoped search tools
+func withSearchScope(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description(t("PARAM_QUERY_DESCRIPTION", "Search query")),
+		)(tool)
+		mcp.WithString("scope",
+			mcp.Description(t("PARAM_SEARCH_SCOPE_DESCRIPTION", "Where to search. Defaults to project when project is given, group when only namespace is given and global otherwise")),
+			mcp.Enum("project", "group", "global"),
+		)(tool)
+		mcp.WithString("namespace",
+			mcp.Description(t("PARAM_SEARCH_NAMESPACE_DESCRIPTION", "The namespace of the project, or the group to search")),
+		)(tool)
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_SEARCH_PROJECT_DESCRIPTION", "The name of the project to search. Requires namespace")),
+		)(tool)
+		withPagination(t)(tool)
+	}
+}
+
+// withSearchRef adds the ref parameter of the searches over repository content
+func withSearchRef(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return mcp.WithString("ref",
+		mcp.Description(t("PARAM_SEARCH_REF_DESCRIPTION", "The branch or tag to search. Only used in project scope and defaults to the default branch")),
+	)
+}
+
+// searchParams reads the parameters added by withSearchScope and withSearchRef
+func searchParams(r mcp.CallToolRequest) (query string, target searchTarget, opts *gitlab.SearchOptions, err error) {
+	if query, err = requiredParam[string](r, "query"); err != nil {
+		return "", target, nil, err
+	}
+	if target.scope, err = OptionalParam[string](r, "scope"); err != nil {
+		return "", target, nil, err
+	}
+	namespace, err := OptionalParam[string](r, "namespace")
+	if err != nil {
+		return "", target, nil, err
+	}
+	project, err := OptionalParam[string](r, "project")
+	if err != nil {
+		return "", target, nil, err
+	}
+	ref, err := OptionalParam[string](r, "ref")
+	if err != nil {
+		return "", target, nil, err
+	}
+	pagination, err := OptionalPaginationParams(r)
+	if err != nil {
+		return "", target, nil, err
+	}
+
+	if target.scope == "" {
+		switch {
+		case project != "":
+			target.scope = "project"
+		case namespace != "":
+			target.scope = "group"
+		default:
+			target.scope = "global"
+		}
+	}
+	switch target.scope {
+	case "project":
+		if namespace == "" || project == "" {
+			return "", target, nil, fmt.Errorf("namespace and project are required for a project search")
+		}
+		target.path = fmt.Sprintf("%s/%s", namespace, project)
+	case "group":
+		if namespace == "" {
+			return "", target, nil, fmt.Errorf("namespace is required for a group search")
+		}
+		target.path = namespace
+	case "global":
+	default:
+		return "", target, nil, fmt.Errorf("parameter scope must be project, group or global, got %s", target.scope)
+	}
+
+	opts = &gitlab.SearchOptions{ListOptions: pagination}
+	if ref != "" && target.scope == "project" {
+		opts.Ref = gitlab.Ptr(ref)
+	}
+	return query, target, opts, nil
+}
+
+// searchResult marshals search hits as the tool response
+func searchResult(hits interface{}) (*mcp.CallToolResult, error) {
+	jsonData, err := json.Marshal(hits)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// codeHit is a match of search_code
+type codeHit struct {
+	ProjectID int    `json:"project_id"`
+	Path      string `json:"path"`
+	Ref       string `json:"ref"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+	Snippet   string `json:"snippet"`
+}
+
+// summarizeBlob turns a blob match into a code hit. The snippet starts at the start line and may include lines around the match.
+func summarizeBlob(blob *gitlab.Blob) codeHit {
+	snippet := strings.TrimRight(blob.Data, "\n")
+	hit := codeHit{
+		ProjectID: blob.ProjectID,
+		Path:      blob.Path,
+		Ref:       blob.Ref,
+		StartLine: blob.Startline,
+		EndLine:   blob.Startline,
+		Snippet:   snippet,
+	}
+	if snippet != "" {
+		hit.EndLine = blob.Startline + strings.Count(snippet, "\n")
+	}
+	return hit
+}
+
+// SearchCode returns a tool for searching file contents
+func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"search_code",
+		mcp.WithDescription(t("TOOL_SEARCH_CODE_DESCRIPTION", "Search file contents in a project, a group or the whole instance. Returns the path, ref, line numbers and a snippet of each match")),
+		withSearchScope(t),
+		withSearchRef(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		query, target, opts, err := searchParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var blobs []*gitlab.Blob
+		switch target.scope {
+		case "project":
+			blobs, _, err = client.Search.BlobsByProject(target.path, query, opts)
+		case "group":
+			blobs, _, err = client.Search.BlobsByGroup(target.path, query, opts)
+		default:
+			blobs, _, err = client.Search.Blobs(query, opts)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to search code: %w", err).Error()), nil
+		}
+
+		hits := make([]codeHit, 0, len(blobs))
+		for _, blob := range blobs {
+			hits = append(hits, summarizeBlob(blob))
+		}
+
+		return searchResult(hits)
+	}
+
+	return tool, handler
+}
+
+// commitHit is a match of search_commits
+type commitHit struct {
+	ID          string     `json:"id"`
+	ShortID     string     `json:"short_id"`
+	Title       string     `json:"title"`
+	AuthorName  string     `json:"author_name"`
+	AuthorEmail string     `json:"author_email"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	ProjectID   int        `json:"project_id"`
+	WebURL      string     `json:"web_url"`
+}
+
+// SearchCommits returns a tool for searching commit messages
+func SearchCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"search_commits",
+		mcp.WithDescription(t("TOOL_SEARCH_COMMITS_DESCRIPTION", "Search commit messages in a project, a group or the whole instance")),
+		withSearchScope(t),
+		withSearchRef(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		query, target, opts, err := searchParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var commits []*gitlab.Commit
+		switch target.scope {
+		case "project":
+			commits, _, err = client.Search.CommitsByProject(target.path, query, opts)
+		case "group":
+			commits, _, err = client.Search.CommitsByGroup(target.path, query, opts)
+		default:
+			commits, _, err = client.Search.Commits(query, opts)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to search commits: %w", err).Error()), nil
+		}
+
+		hits := make([]commitHit, 0, len(commits))
+		for _, commit := range commits {
+			hits = append(hits, commitHit{
+				ID:          commit.ID,
+				ShortID:     commit.ShortID,
+				Title:       commit.Title,
+				AuthorName:  commit.AuthorName,
+				AuthorEmail: commit.AuthorEmail,
+				CreatedAt:   commit.CreatedAt,
+				ProjectID:   commit.ProjectID,
+				WebURL:      commit.WebURL,
+			})
+		}
+
+		return searchResult(hits)
+	}
+
+	return tool, handler
+}
+
+// wikiSearchOptions are the query parameters of a wiki_blobs search
+type wikiSearchOptions struct {
+	gitlab.SearchOptions
+	Scope  string `url:"scope" json:"scope"`
+	Search string `url:"search" json:"search"`
+}
+
+// searchWikiBlobs runs a wiki_blobs search. GitLab returns wiki matches in the same shape as code matches,
+// which Search.WikiBlobs decodes into gitlab.Wiki and so loses the path, ref and lines of each match.
+func searchWikiBlobs(client *gitlab.Client, target searchTarget, query string, opts *gitlab.SearchOptions) ([]*gitlab.Blob, error) {
+	path := "search"
+	switch target.scope {
+	case "project":
+		path = fmt.Sprintf("projects/%s/-/search", gitlab.PathEscape(target.path))
+	case "group":
+		path = fmt.Sprintf("groups/%s/-/search", gitlab.PathEscape(target.path))
+	}
+
+	req, err := client.NewRequest(http.MethodGet, path, &wikiSearchOptions{SearchOptions: *opts, Scope: "wiki_blobs", Search: query}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var blobs []*gitlab.Blob
+	if _, err := client.Do(req, &blobs); err != nil {
+		return nil, err
+	}
+	return blobs, nil
+}
+
+// SearchWiki returns a tool for searching wiki pages
+func SearchWiki(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"search_wiki",
+		mcp.WithDescription(t("TOOL_SEARCH_WIKI_DESCRIPTION", "Search wiki pages in a project, a group or the whole instance. Returns the page path, line numbers and a snippet of each match")),
+		withSearchScope(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		query, target, opts, err := searchParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		blobs, err := searchWikiBlobs(client, target, query, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to search wiki: %w", err).Error()), nil
+		}
+
+		hits := make([]codeHit, 0, len(blobs))
+		for _, blob := range blobs {
+			hits = append(hits, summarizeBlob(blob))
+		}
+
+		return searchResult(hits)
+	}
+
+	return tool, handler
+}
+
+// noteHit is a match of search_notes
+type noteHit struct {
+	ID           int        `json:"id"`
+	Body         string     `json:"body"`
+	Author       string     `json:"author"`
+	NoteableType string     `json:"noteable_type"`
+	NoteableIID  int        `json:"noteable_iid,omitempty"`
+	CommitID     string     `json:"commit_id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+}
+
+// SearchNotes returns a tool for searching comments
+func SearchNotes(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"search_notes",
+		mcp.WithDescription(t("TOOL_SEARCH_NOTES_DESCRIPTION", "Search the comments on issues, merge requests, commits and snippets of a project. GitLab only supports this in project scope")),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description(t("PARAM_QUERY_DESCRIPTION", "Search query")),
+		),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		query, err := requiredParam[string](r, "query")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.SearchOptions{ListOptions: pagination}
+		notes, _, err := client.Search.NotesByProject(fmt.Sprintf("%s/%s", namespace, project), query, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to search notes: %w", err).Error()), nil
+		}
+
+		hits := make([]noteHit, 0, len(notes))
+		for _, note := range notes {
+			hits = append(hits, noteHit{
+				ID:           note.ID,
+				Body:         note.Body,
+				Author:       note.Author.Username,
+				NoteableType: note.NoteableType,
+				NoteableIID:  note.NoteableIID,
+				CommitID:     note.CommitID,
+				CreatedAt:    note.CreatedAt,
+			})
+		}
+
+		return searchResult(hits)
+	}
+
+	return tool, handler
+}
+
+// SearchMilestones returns a tool for searching milestones
+func SearchMilestones(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"search_milestones",
+		mcp.WithDescription(t("TOOL_SEARCH_MILESTONES_DESCRIPTION", "Search milestones by title and description in a project, a group or the whole instance")),
+		withSearchScope(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		query, target, opts, err := searchParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var milestones []*gitlab.Milestone
+		switch target.scope {
+		case "project":
+			milestones, _, err = client.Search.MilestonesByProject(target.path, query, opts)
+		case "group":
+			milestones, _, err = client.Search.MilestonesByGroup(target.path, query, opts)
+		default:
+			milestones, _, err = client.Search.Milestones(query, opts)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to search milestones: %w", err).Error()), nil
+		}
+
+		return searchResult(milestones)
+	}
+
+	return tool, handler
+}
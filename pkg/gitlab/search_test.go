@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -320,3 +321,464 @@ func TestSearchUsers(t *testing.T) {
 		})
 	}
 }
+
+func TestSummarizeBlob(t *testing.T) {
+	hit := summarizeBlob(&gitlab.Blob{
+		ProjectID: 6,
+		Path:      "pkg/cache/cache.go",
+		Ref:       "main",
+		Startline: 40,
+		Data:      "func Evict() {\n\tlru.Purge()\n}\n",
+	})
+
+	assert.Equal(t, codeHit{
+		ProjectID: 6,
+		Path:      "pkg/cache/cache.go",
+		Ref:       "main",
+		StartLine: 40,
+		EndLine:   42,
+		Snippet:   "func Evict() {\n\tlru.Purge()\n}",
+	}, hit)
+}
+
+func TestSearchCode(t *testing.T) {
+	blobs := []*gitlab.Blob{{ProjectID: 6, Path: "main.go", Ref: "main", Startline: 3, Data: "lru.Purge()\n"}}
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedScope string
+		expectedPath  interface{}
+		expectedRef   *string
+		mockError     error
+		expectedError string
+	}{
+		{
+			name: "project scope inferred with ref",
+			args: map[string]interface{}{
+				"query":     "Purge",
+				"namespace": "group",
+				"project":   "project",
+				"ref":       "develop",
+			},
+			expectedScope: "project",
+			expectedPath:  "group/project",
+			expectedRef:   gitlab.Ptr("develop"),
+		},
+		{
+			name: "group scope inferred, ref ignored",
+			args: map[string]interface{}{
+				"query":     "Purge",
+				"namespace": "group",
+				"ref":       "develop",
+			},
+			expectedScope: "group",
+			expectedPath:  "group",
+		},
+		{
+			name: "global scope",
+			args: map[string]interface{}{
+				"query":     "Purge",
+				"scope":     "global",
+				"namespace": "group",
+			},
+			expectedScope: "global",
+		},
+		{
+			name: "project scope without project",
+			args: map[string]interface{}{
+				"query":     "Purge",
+				"scope":     "project",
+				"namespace": "group",
+			},
+			expectedError: "namespace and project are required for a project search",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"query": "Purge",
+			},
+			expectedScope: "global",
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to search code: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var scope string
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Search: &mockSearchService{
+						searchBlobFunc: func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Blob, *gitlab.Response, error) {
+							scope = "global"
+							return blobs, ok, tc.mockError
+						},
+						searchBlobByGroupFunc: func(gid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Blob, *gitlab.Response, error) {
+							scope = "group"
+							assert.Equal(t, tc.expectedPath, gid)
+							assert.Equal(t, tc.expectedRef, opt.Ref)
+							return blobs, ok, tc.mockError
+						},
+						searchBlobByProjectFunc: func(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Blob, *gitlab.Response, error) {
+							scope = "project"
+							assert.Equal(t, tc.expectedPath, pid)
+							assert.Equal(t, tc.expectedRef, opt.Ref)
+							return blobs, ok, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := SearchCode(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedScope, scope)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got []codeHit
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, []codeHit{{ProjectID: 6, Path: "main.go", Ref: "main", StartLine: 3, EndLine: 3, Snippet: "lru.Purge()"}}, got)
+		})
+	}
+}
+
+func TestSearchCommits(t *testing.T) {
+	commits := []*gitlab.Commit{{ID: "a1b2c3d4", ShortID: "a1b2c3d", Title: "Fix flaky cache test", AuthorName: "Alice", AuthorEmail: "alice@example.com", ProjectID: 6, WebURL: "https://gitlab.example.com/group/project/-/commit/a1b2c3d4"}}
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedScope string
+		expectedPath  interface{}
+		expectedRef   *string
+		mockError     error
+		expectedError string
+	}{
+		{
+			name: "project scope with ref",
+			args: map[string]interface{}{
+				"query":     "flaky",
+				"namespace": "group",
+				"project":   "project",
+				"ref":       "develop",
+			},
+			expectedScope: "project",
+			expectedPath:  "group/project",
+			expectedRef:   gitlab.Ptr("develop"),
+		},
+		{
+			name: "group scope",
+			args: map[string]interface{}{
+				"query":     "flaky",
+				"scope":     "group",
+				"namespace": "group",
+			},
+			expectedScope: "group",
+			expectedPath:  "group",
+		},
+		{
+			name: "global scope",
+			args: map[string]interface{}{
+				"query": "flaky",
+			},
+			expectedScope: "global",
+		},
+		{
+			name: "group scope without namespace",
+			args: map[string]interface{}{
+				"query": "flaky",
+				"scope": "group",
+			},
+			expectedError: "namespace is required for a group search",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"query":     "flaky",
+				"namespace": "group",
+				"project":   "project",
+			},
+			expectedScope: "project",
+			expectedPath:  "group/project",
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to search commits: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var scope string
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Search: &mockSearchService{
+						searchCommitFunc: func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error) {
+							scope = "global"
+							return commits, ok, tc.mockError
+						},
+						searchCommitByGroupFunc: func(gid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error) {
+							scope = "group"
+							assert.Equal(t, tc.expectedPath, gid)
+							return commits, ok, tc.mockError
+						},
+						searchCommitByProjectFunc: func(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error) {
+							scope = "project"
+							assert.Equal(t, tc.expectedPath, pid)
+							assert.Equal(t, tc.expectedRef, opt.Ref)
+							return commits, ok, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := SearchCommits(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedScope, scope)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got []commitHit
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, []commitHit{{
+				ID:          "a1b2c3d4",
+				ShortID:     "a1b2c3d",
+				Title:       "Fix flaky cache test",
+				AuthorName:  "Alice",
+				AuthorEmail: "alice@example.com",
+				ProjectID:   6,
+				WebURL:      "https://gitlab.example.com/group/project/-/commit/a1b2c3d4",
+			}}, got)
+		})
+	}
+}
+
+func TestSearchMilestones(t *testing.T) {
+	milestones := []*gitlab.Milestone{{ID: 12, IID: 3, ProjectID: 6, Title: "v1.2", State: "active"}}
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedScope string
+		expectedPath  interface{}
+		mockError     error
+		expectedError string
+	}{
+		{
+			name: "project scope inferred",
+			args: map[string]interface{}{
+				"query":     "v1.2",
+				"namespace": "group",
+				"project":   "project",
+			},
+			expectedScope: "project",
+			expectedPath:  "group/project",
+		},
+		{
+			name: "group scope inferred",
+			args: map[string]interface{}{
+				"query":     "v1.2",
+				"namespace": "group",
+			},
+			expectedScope: "group",
+			expectedPath:  "group",
+		},
+		{
+			name: "global scope",
+			args: map[string]interface{}{
+				"query":     "v1.2",
+				"scope":     "global",
+				"namespace": "group",
+			},
+			expectedScope: "global",
+		},
+		{
+			name: "invalid scope",
+			args: map[string]interface{}{
+				"query": "v1.2",
+				"scope": "instance",
+			},
+			expectedError: "parameter scope must be project, group or global, got instance",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"query":     "v1.2",
+				"namespace": "group",
+			},
+			expectedScope: "group",
+			expectedPath:  "group",
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to search milestones: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var scope string
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Search: &mockSearchService{
+						searchMilestoneFunc: func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error) {
+							scope = "global"
+							return milestones, ok, tc.mockError
+						},
+						searchMilestoneByGroupFunc: func(gid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error) {
+							scope = "group"
+							assert.Equal(t, tc.expectedPath, gid)
+							return milestones, ok, tc.mockError
+						},
+						searchMilestoneByProjectFunc: func(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error) {
+							scope = "project"
+							assert.Equal(t, tc.expectedPath, pid)
+							return milestones, ok, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := SearchMilestones(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedScope, scope)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got []*gitlab.Milestone
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, milestones, got)
+		})
+	}
+}
+
+func TestSearchNotes(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expected      []noteHit
+		expectedError string
+	}{
+		{
+			name: "notes of a project",
+			args: map[string]interface{}{
+				"query":     "flaky",
+				"namespace": "group",
+				"project":   "project",
+			},
+			expected: []noteHit{{ID: 9, Body: "This test is flaky", Author: "alice", NoteableType: "Issue", NoteableIID: 4}},
+		},
+		{
+			name: "missing project",
+			args: map[string]interface{}{
+				"query":     "flaky",
+				"namespace": "group",
+			},
+			expectedError: "missing required parameter: project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Search: &mockSearchService{
+						searchNoteByProjectFunc: func(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							note := &gitlab.Note{ID: 9, Body: "This test is flaky", NoteableType: "Issue", NoteableIID: 4}
+							note.Author.Username = "alice"
+							return []*gitlab.Note{note}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := SearchNotes(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got []noteHit
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestSearchWiki(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/groups/group/-/search", r.URL.Path)
+		assert.Equal(t, "wiki_blobs", r.URL.Query().Get("scope"))
+		assert.Equal(t, "runbook", r.URL.Query().Get("search"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"basename":"ops/runbook","data":"# Runbook\nrestart the runbook service\n","path":"ops/runbook.md","filename":"ops/runbook.md","ref":"main","startline":1,"project_id":6}]`)
+	}))
+	defer srv.Close()
+
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := SearchWiki(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"query":     "runbook",
+		"namespace": "group",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var got []codeHit
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.Equal(t, []codeHit{{ProjectID: 6, Path: "ops/runbook.md", Ref: "main", StartLine: 1, EndLine: 2, Snippet: "# Runbook\nrestart the runbook service"}}, got)
+}
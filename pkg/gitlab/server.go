@@ -58,6 +58,12 @@ func NewServer(getClient GetClientFn, version string, readOnly bool, t translati
 	tool, toolHandler = GetIssueComments(getClient, t)
 	s.AddTool(tool, toolHandler)
 
+	tool, toolHandler = ListIssueLinks(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetIssueHistory(getClient, t)
+	s.AddTool(tool, toolHandler)
+
 	if !readOnly {
 		tool, toolHandler = CreateIssue(getClient, t)
 		s.AddTool(tool, toolHandler)
@@ -67,6 +73,12 @@ func NewServer(getClient GetClientFn, version string, readOnly bool, t translati
 
 		tool, toolHandler = UpdateIssue(getClient, t)
 		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = CreateIssueLink(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = DeleteIssueLink(getClient, t)
+		s.AddTool(tool, toolHandler)
 	}
 
 	// Add GitLab tools - Merge Requests
@@ -79,6 +91,9 @@ func NewServer(getClient GetClientFn, version string, readOnly bool, t translati
 	tool, toolHandler = GetMergeRequestComments(getClient, t)
 	s.AddTool(tool, toolHandler)
 
+	tool, toolHandler = GetMergeRequestHistory(getClient, t)
+	s.AddTool(tool, toolHandler)
+
 	if !readOnly {
 		tool, toolHandler = CreateMergeRequest(getClient, t)
 		s.AddTool(tool, toolHandler)
@@ -90,6 +105,117 @@ func NewServer(getClient GetClientFn, version string, readOnly bool, t translati
 		s.AddTool(tool, toolHandler)
 	}
 
+	// Add GitLab tools - Labels
+	tool, toolHandler = ListLabels(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = CreateLabel(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = UpdateLabel(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = DeleteLabel(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = SetIssueLabels(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = SetMergeRequestLabels(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - Milestones and Iterations
+	tool, toolHandler = ListMilestones(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetMilestone(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetMilestoneReport(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = ListIterations(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetIteration(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = CreateMilestone(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = CloseMilestone(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = CreateIteration(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - Epics
+	tool, toolHandler = ListEpics(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetEpic(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = ListEpicChildren(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = CreateEpic(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = UpdateEpic(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = AttachIssueToEpic(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = DetachIssueFromEpic(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - Boards
+	tool, toolHandler = GetBoard(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = MoveIssueOnBoard(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - Time tracking
+	tool, toolHandler = GetTimeStats(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = TimeReport(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = SetTimeEstimate(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = AddSpentTime(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = ResetSpentTime(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - Reactions
+	tool, toolHandler = ListAwardEmoji(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = AddAwardEmoji(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = RemoveAwardEmoji(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
 	// Add GitLab tools - Repository
 	tool, toolHandler = GetRepository(getClient, t)
 	s.AddTool(tool, toolHandler)
@@ -100,6 +226,215 @@ func NewServer(getClient GetClientFn, version string, readOnly bool, t translati
 	tool, toolHandler = SearchRepositories(getClient, t)
 	s.AddTool(tool, toolHandler)
 
+	// Add GitLab tools - Commits
+	tool, toolHandler = ListCommits(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetCommit(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = CompareRefs(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	// Add GitLab tools - Branches
+	tool, toolHandler = ListBranches(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetBranch(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = CreateBranch(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = DeleteBranch(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = DeleteMergedBranches(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - Repository protection
+	tool, toolHandler = ListProtectedBranches(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetProtectedBranch(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = ListProtectedTags(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetProtectedTag(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetPushRules(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = ProtectBranch(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = UnprotectBranch(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = ProtectTag(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = UnprotectTag(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - Code owners
+	tool, toolHandler = GetCodeOwners(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	// Add GitLab tools - Tags and Releases
+	tool, toolHandler = ListTags(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetTag(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = ListReleases(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetRelease(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GenerateChangelog(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = CreateTag(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = CreateRelease(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = UpdateRelease(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - Environments and deployments
+	tool, toolHandler = ListEnvironments(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = ListDeployments(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetDeployment(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = TraceChangeDeployment(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = StopEnvironment(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - CI/CD variables
+	tool, toolHandler = ListCIVariables(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = CreateCIVariable(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = UpdateCIVariable(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = DeleteCIVariable(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - CI/CD configuration
+	tool, toolHandler = LintCIConfig(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	// Add GitLab tools - Pipeline schedules and triggers
+	tool, toolHandler = ListPipelineSchedules(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetPipelineSchedule(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = ListPipelineTriggers(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = CreatePipelineSchedule(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = UpdatePipelineSchedule(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = TakePipelineScheduleOwnership(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = PlayPipelineSchedule(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = SetPipelineScheduleVariable(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = DeletePipelineScheduleVariable(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = RunPipelineTrigger(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - Pipeline reports
+	tool, toolHandler = GetPipelineTestReport(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetCoverageTrend(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	// Add GitLab tools - Jobs
+	tool, toolHandler = ListManualJobs(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = PlayJob(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = RetryJob(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = CancelJob(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = EraseJob(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
+	// Add GitLab tools - Job artifacts
+	tool, toolHandler = ListJobArtifacts(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetJobArtifactFile(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = GetLatestJobArtifactFile(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	// Add GitLab tools - Runners
+	tool, toolHandler = ListRunners(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = ListRunnerJobs(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = PauseRunner(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = ResumeRunner(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
 	// Add GitLab tools - Search
 	tool, toolHandler = SearchProjects(getClient, t)
 	s.AddTool(tool, toolHandler)
@@ -110,10 +445,46 @@ func NewServer(getClient GetClientFn, version string, readOnly bool, t translati
 	tool, toolHandler = SearchUsers(getClient, t)
 	s.AddTool(tool, toolHandler)
 
+	tool, toolHandler = SearchCode(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = SearchCommits(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = SearchWiki(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = SearchNotes(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = SearchMilestones(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	// Add GitLab tools - Events
+	tool, toolHandler = ListUserEvents(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = ListProjectEvents(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	tool, toolHandler = ListMyContributionEvents(getClient, t)
+	s.AddTool(tool, toolHandler)
+
 	// Add GitLab tools - User
 	tool, toolHandler = GetMe(getClient, t)
 	s.AddTool(tool, toolHandler)
 
+	tool, toolHandler = ListTodos(getClient, t)
+	s.AddTool(tool, toolHandler)
+
+	if !readOnly {
+		tool, toolHandler = MarkTodoDone(getClient, t)
+		s.AddTool(tool, toolHandler)
+
+		tool, toolHandler = MarkAllTodosDone(getClient, t)
+		s.AddTool(tool, toolHandler)
+	}
+
 	return s
 }
 
@@ -23,13 +23,13 @@ func TestNewServer(t *testing.T) {
 			name:      "read-only mode",
 			version:   "1.0.0",
 			readOnly:  true,
-			wantTools: 14, // Number of tools in read-only mode
+			wantTools: 72, // Number of tools in read-only mode
 		},
 		{
 			name:      "read-write mode",
 			version:   "1.0.0",
 			readOnly:  false,
-			wantTools: 20, // Number of tools in read-write mode
+			wantTools: 127, // Number of tools in read-write mode
 		},
 	}
 
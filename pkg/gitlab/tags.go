@@ -0,0 +1,239 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListTags returns a tool for listing tags in a project
+func ListTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_tags",
+		mcp.WithDescription(t("TOOL_LIST_TAGS_DESCRIPTION", "List tags in a project")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("search",
+			mcp.Description(t("PARAM_TAG_SEARCH_DESCRIPTION", "Only return tags matching this search; use ^term or term$ to match the start or end")),
+		),
+		mcp.WithString("order_by",
+			mcp.Description(t("PARAM_TAG_ORDER_BY_DESCRIPTION", "Order tags by name, updated or version")),
+			mcp.Enum("name", "updated", "version"),
+		),
+		mcp.WithString("sort",
+			mcp.Description(t("PARAM_SORT_DESCRIPTION", "Sort order")),
+			mcp.Enum("asc", "desc"),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		search, err := OptionalParam[string](r, "search")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		orderBy, err := OptionalParam[string](r, "order_by")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		sort, err := OptionalParam[string](r, "sort")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.ListTagsOptions{ListOptions: pagination}
+		if search != "" {
+			opts.Search = &search
+		}
+		if orderBy != "" {
+			opts.OrderBy = &orderBy
+		}
+		if sort != "" {
+			opts.Sort = &sort
+		}
+
+		tags, _, err := client.Tags.ListTags(
+			fmt.Sprintf("%s/%s", namespace, project),
+			opts,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list tags: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(tags)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// GetTag returns a tool for getting a single tag
+func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_tag",
+		mcp.WithDescription(t("TOOL_GET_TAG_DESCRIPTION", "Get a specific tag, including its commit and release notes")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("tag_name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_TAG_NAME_DESCRIPTION", "The name of the tag")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagName, err := requiredParam[string](r, "tag_name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		tag, _, err := client.Tags.GetTag(
+			fmt.Sprintf("%s/%s", namespace, project),
+			tagName,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get tag: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(tag)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// CreateTag returns a tool for creating a new tag
+func CreateTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"create_tag",
+		mcp.WithDescription(t("TOOL_CREATE_TAG_DESCRIPTION", "Create a new tag")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		),
+		mcp.WithString("tag_name",
+			mcp.Required(),
+			mcp.Description(t("PARAM_TAG_NAME_DESCRIPTION", "The name of the tag")),
+		),
+		mcp.WithString("ref",
+			mcp.Required(),
+			mcp.Description(t("PARAM_TAG_REF_DESCRIPTION", "The branch name or commit SHA to create the tag from")),
+		),
+		mcp.WithString("message",
+			mcp.Description(t("PARAM_TAG_MESSAGE_DESCRIPTION", "Message for an annotated tag; a lightweight tag is created when empty")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := requiredParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagName, err := requiredParam[string](r, "tag_name")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref, err := requiredParam[string](r, "ref")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		message, err := OptionalParam[string](r, "message")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := &gitlab.CreateTagOptions{
+			TagName: &tagName,
+			Ref:     &ref,
+		}
+		if message != "" {
+			opts.Message = &message
+		}
+
+		tag, _, err := client.Tags.CreateTag(
+			fmt.Sprintf("%s/%s", namespace, project),
+			opts,
+		)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to create tag: %w", err).Error()), nil
+		}
+
+		jsonData, err := json.Marshal(tag)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
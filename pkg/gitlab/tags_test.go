@@ -0,0 +1,288 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockTagsService is a mock implementation of the GitLab tags service
+type mockTagsService struct {
+	listFunc   func(pid interface{}, opt *gitlab.ListTagsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Tag, *gitlab.Response, error)
+	getFunc    func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error)
+	createFunc func(pid interface{}, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error)
+}
+
+// ensure mockTagsService implements the gitlab.TagsServiceInterface
+var _ gitlab.TagsServiceInterface = &mockTagsService{}
+
+func (m *mockTagsService) ListTags(pid interface{}, opt *gitlab.ListTagsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Tag, *gitlab.Response, error) {
+	return m.listFunc(pid, opt, options...)
+}
+
+func (m *mockTagsService) GetTag(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+	return m.getFunc(pid, tag, options...)
+}
+
+func (m *mockTagsService) CreateTag(pid interface{}, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+	return m.createFunc(pid, opt, options...)
+}
+
+func (m *mockTagsService) DeleteTag(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func TestListTags(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            map[string]interface{}
+		expectedSearch  *string
+		expectedOrderBy *string
+		expectedSort    *string
+		expectedError   string
+	}{
+		{
+			name: "all tags",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+			},
+		},
+		{
+			name: "search ordered by version",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"search":    "^v1",
+				"order_by":  "version",
+				"sort":      "desc",
+			},
+			expectedSearch:  gitlab.Ptr("^v1"),
+			expectedOrderBy: gitlab.Ptr("version"),
+			expectedSort:    gitlab.Ptr("desc"),
+		},
+		{
+			name: "search of the wrong type",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"search":    float64(1),
+			},
+			expectedError: "parameter search is not of type string",
+		},
+		{
+			name: "missing project",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+			},
+			expectedError: "missing required parameter: project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Tags: &mockTagsService{
+						listFunc: func(pid interface{}, opt *gitlab.ListTagsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Tag, *gitlab.Response, error) {
+							assert.Equal(t, "test-namespace/test-project", pid)
+							assert.Equal(t, tc.expectedSearch, opt.Search)
+							assert.Equal(t, tc.expectedOrderBy, opt.OrderBy)
+							assert.Equal(t, tc.expectedSort, opt.Sort)
+							return []*gitlab.Tag{{Name: "v1.1.0"}, {Name: "v1.0.0"}}, nil, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListTags(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var tags []*gitlab.Tag
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &tags))
+			require.Len(t, tags, 2)
+			assert.Equal(t, "v1.1.0", tags[0].Name)
+		})
+	}
+}
+
+func TestGetTag(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		getError      error
+		expectedError string
+	}{
+		{
+			name: "tag with release notes",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"tag_name":  "v1.0.0",
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"tag_name":  "v9.9.9",
+			},
+			getError:      fmt.Errorf("404 Not Found"),
+			expectedError: "failed to get tag: 404 Not Found",
+		},
+		{
+			name: "missing tag name",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+			},
+			expectedError: "missing required parameter: tag_name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Tags: &mockTagsService{
+						getFunc: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+							assert.Equal(t, "test-namespace/test-project", pid)
+							if tc.getError != nil {
+								return nil, nil, tc.getError
+							}
+							return &gitlab.Tag{
+								Name:    tag,
+								Commit:  &gitlab.Commit{ID: "abc123"},
+								Release: &gitlab.ReleaseNote{TagName: tag, Description: "First release"},
+							}, nil, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := GetTag(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var tag gitlab.Tag
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &tag))
+			assert.Equal(t, "v1.0.0", tag.Name)
+			assert.Equal(t, "abc123", tag.Commit.ID)
+			assert.Equal(t, "First release", tag.Release.Description)
+		})
+	}
+}
+
+func TestCreateTag(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            map[string]interface{}
+		expectedMessage *string
+		expectedError   string
+	}{
+		{
+			name: "lightweight tag",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"tag_name":  "v1.0.0",
+				"ref":       "main",
+			},
+		},
+		{
+			name: "annotated tag",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"tag_name":  "v1.0.0",
+				"ref":       "main",
+				"message":   "Release 1.0.0",
+			},
+			expectedMessage: gitlab.Ptr("Release 1.0.0"),
+		},
+		{
+			name: "missing ref",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"tag_name":  "v1.0.0",
+			},
+			expectedError: "missing required parameter: ref",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Tags: &mockTagsService{
+						createFunc: func(pid interface{}, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+							assert.Equal(t, "test-namespace/test-project", pid)
+							assert.Equal(t, "main", *opt.Ref)
+							assert.Equal(t, tc.expectedMessage, opt.Message)
+							tag := &gitlab.Tag{Name: *opt.TagName}
+							if opt.Message != nil {
+								tag.Message = *opt.Message
+							}
+							return tag, nil, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := CreateTag(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var tag gitlab.Tag
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &tag))
+			assert.Equal(t, "v1.0.0", tag.Name)
+			if tc.expectedMessage != nil {
+				assert.Equal(t, *tc.expectedMessage, tag.Message)
+			}
+		})
+	}
+}
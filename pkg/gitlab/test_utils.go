@@ -8,9 +8,19 @@ import (
 
 // mockSearchService is a mock implementation of the GitLab search service
 type mockSearchService struct {
-	searchProjectFunc      func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error)
-	searchMergeRequestFunc func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.MergeRequest, *gitlab.Response, error)
-	searchUserFunc         func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+	searchProjectFunc            func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error)
+	searchMergeRequestFunc       func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.MergeRequest, *gitlab.Response, error)
+	searchUserFunc               func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+	searchBlobFunc               func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Blob, *gitlab.Response, error)
+	searchBlobByGroupFunc        func(gid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Blob, *gitlab.Response, error)
+	searchBlobByProjectFunc      func(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Blob, *gitlab.Response, error)
+	searchNoteByProjectFunc      func(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error)
+	searchCommitFunc             func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error)
+	searchCommitByGroupFunc      func(gid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error)
+	searchCommitByProjectFunc    func(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error)
+	searchMilestoneFunc          func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error)
+	searchMilestoneByGroupFunc   func(gid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error)
+	searchMilestoneByProjectFunc func(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error)
 }
 
 // ensure mockSearchService implements the gitlab.SearchServiceInterface
@@ -53,43 +63,43 @@ func (m *mockSearchService) MergeRequestsByProject(pid interface{}, query string
 }
 
 func (m *mockSearchService) Milestones(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.searchMilestoneFunc(query, opt, options...)
 }
 
 func (m *mockSearchService) MilestonesByGroup(gid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.searchMilestoneByGroupFunc(gid, query, opt, options...)
 }
 
 func (m *mockSearchService) MilestonesByProject(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.searchMilestoneByProjectFunc(pid, query, opt, options...)
 }
 
 func (m *mockSearchService) Blobs(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Blob, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.searchBlobFunc(query, opt, options...)
 }
 
 func (m *mockSearchService) BlobsByGroup(gid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Blob, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.searchBlobByGroupFunc(gid, query, opt, options...)
 }
 
 func (m *mockSearchService) BlobsByProject(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Blob, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.searchBlobByProjectFunc(pid, query, opt, options...)
 }
 
 func (m *mockSearchService) Commits(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.searchCommitFunc(query, opt, options...)
 }
 
 func (m *mockSearchService) CommitsByGroup(gid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.searchCommitByGroupFunc(gid, query, opt, options...)
 }
 
 func (m *mockSearchService) CommitsByProject(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Commit, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.searchCommitByProjectFunc(pid, query, opt, options...)
 }
 
 func (m *mockSearchService) NotesByProject(pid interface{}, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error) {
-	return nil, nil, nil
+	return m.searchNoteByProjectFunc(pid, query, opt, options...)
 }
 
 func (m *mockSearchService) SnippetBlobs(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Snippet, *gitlab.Response, error) {
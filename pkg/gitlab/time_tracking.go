@@ -0,0 +1,597 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// trackedDurationUnits are the seconds in each time tracking unit, using GitLab's
+// working time conversion: a day is 8 hours, a week 5 days and a month 4 weeks
+var trackedDurationUnits = []struct {
+	unit    string
+	seconds int
+}{
+	{"mo", 4 * 5 * 8 * 3600},
+	{"w", 5 * 8 * 3600},
+	{"d", 8 * 3600},
+	{"h", 3600},
+	{"m", 60},
+	{"s", 1},
+}
+
+// trackedDurationPart matches one amount of a duration such as 1h, 30m or 1.5d.
+// A bare number is read as hours, like the /spend quick action does.
+var trackedDurationPart = regexp.MustCompile(`^(\d+(?:\.\d+)?)(mo|w|d|h|m|s)?`)
+
+// parseTrackedDuration parses a human duration such as "1h30m", "2d 4h" or "-30m" into seconds
+func parseTrackedDuration(s string) (int, error) {
+	rest := strings.ReplaceAll(strings.TrimSpace(s), " ", "")
+	negative := strings.HasPrefix(rest, "-")
+	rest = strings.TrimPrefix(rest, "-")
+	if rest == "" {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	var total float64
+	for rest != "" {
+		match := trackedDurationPart.FindStringSubmatch(rest)
+		if match == nil {
+			return 0, fmt.Errorf("invalid duration %q: use amounts with the units mo, w, d, h, m or s, such as 1h30m", s)
+		}
+		amount, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		unit := match[2]
+		if unit == "" {
+			unit = "h"
+		}
+		for _, u := range trackedDurationUnits {
+			if u.unit == unit {
+				total += amount * float64(u.seconds)
+				break
+			}
+		}
+		rest = rest[len(match[0]):]
+	}
+
+	seconds := int(total)
+	if negative {
+		seconds = -seconds
+	}
+	return seconds, nil
+}
+
+// formatTrackedDuration renders seconds the way GitLab shows tracked time, such as "1w 2d 3h 30m"
+func formatTrackedDuration(seconds int) string {
+	if seconds == 0 {
+		return "0m"
+	}
+
+	sign := ""
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+
+	var parts []string
+	// months are left out, as in GitLab's own display of tracked time
+	for _, u := range trackedDurationUnits[1:] {
+		if seconds >= u.seconds {
+			parts = append(parts, fmt.Sprintf("%d%s", seconds/u.seconds, u.unit))
+			seconds %= u.seconds
+		}
+	}
+	return sign + strings.Join(parts, " ")
+}
+
+// withTimeTrackingTarget adds the parameters identifying the issue or merge request of the time tracking tools
+func withTimeTrackingTarget(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		)(tool)
+		mcp.WithString("project",
+			mcp.Required(),
+			mcp.Description(t("PARAM_PROJECT_DESCRIPTION", "The name of the project")),
+		)(tool)
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_TIME_TRACKING_ID_DESCRIPTION", "The ID of the issue or merge request")),
+		)(tool)
+		mcp.WithString("item_type",
+			mcp.Description(t("PARAM_TIME_TRACKING_ITEM_TYPE_DESCRIPTION", "Whether id is an issue or a merge request. Defaults to issue")),
+			mcp.Enum("issue", "merge_request"),
+		)(tool)
+	}
+}
+
+// timeTrackingTarget reads the parameters added by withTimeTrackingTarget
+func timeTrackingTarget(r mcp.CallToolRequest) (projectID string, id int, mergeRequest bool, err error) {
+	namespace, err := requiredParam[string](r, "namespace")
+	if err != nil {
+		return "", 0, false, err
+	}
+	project, err := requiredParam[string](r, "project")
+	if err != nil {
+		return "", 0, false, err
+	}
+	if id, err = RequiredInt(r, "id"); err != nil {
+		return "", 0, false, err
+	}
+	itemType, err := OptionalParam[string](r, "item_type")
+	if err != nil {
+		return "", 0, false, err
+	}
+	switch itemType {
+	case "", "issue":
+	case "merge_request":
+		mergeRequest = true
+	default:
+		return "", 0, false, fmt.Errorf("parameter item_type must be issue or merge_request, got %s", itemType)
+	}
+	return fmt.Sprintf("%s/%s", namespace, project), id, mergeRequest, nil
+}
+
+// timeStatsResult marshals time stats as the tool response
+func timeStatsResult(stats *gitlab.TimeStats) (*mcp.CallToolResult, error) {
+	jsonData, err := json.Marshal(stats)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// GetTimeStats returns a tool for getting the estimated and spent time of an issue or merge request
+func GetTimeStats(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"get_time_stats",
+		mcp.WithDescription(t("TOOL_GET_TIME_STATS_DESCRIPTION", "Get the time estimate and total time spent of an issue or merge request")),
+		withTimeTrackingTarget(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		projectID, id, mergeRequest, err := timeTrackingTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var stats *gitlab.TimeStats
+		if mergeRequest {
+			stats, _, err = client.MergeRequests.GetTimeSpent(projectID, id)
+		} else {
+			stats, _, err = client.Issues.GetTimeSpent(projectID, id)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get time stats: %w", err).Error()), nil
+		}
+
+		return timeStatsResult(stats)
+	}
+
+	return tool, handler
+}
+
+// SetTimeEstimate returns a tool for setting the time estimate of an issue or merge request
+func SetTimeEstimate(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"set_time_estimate",
+		mcp.WithDescription(t("TOOL_SET_TIME_ESTIMATE_DESCRIPTION", "Set the time estimate of an issue or merge request. An estimate of 0 removes it")),
+		withTimeTrackingTarget(t),
+		mcp.WithString("duration",
+			mcp.Required(),
+			mcp.Description(t("PARAM_TIME_ESTIMATE_DURATION_DESCRIPTION", "The estimate, such as 1h30m or 3d. A day is 8 hours and a week 5 days")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		projectID, id, mergeRequest, err := timeTrackingTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		duration, err := requiredParam[string](r, "duration")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		seconds, err := parseTrackedDuration(duration)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if seconds < 0 {
+			return mcp.NewToolResultError("a time estimate cannot be negative"), nil
+		}
+
+		var stats *gitlab.TimeStats
+		switch {
+		case seconds == 0 && mergeRequest:
+			stats, _, err = client.MergeRequests.ResetTimeEstimate(projectID, id)
+		case seconds == 0:
+			stats, _, err = client.Issues.ResetTimeEstimate(projectID, id)
+		case mergeRequest:
+			stats, _, err = client.MergeRequests.SetTimeEstimate(projectID, id, &gitlab.SetTimeEstimateOptions{
+				Duration: gitlab.Ptr(formatTrackedDuration(seconds)),
+			})
+		default:
+			stats, _, err = client.Issues.SetTimeEstimate(projectID, id, &gitlab.SetTimeEstimateOptions{
+				Duration: gitlab.Ptr(formatTrackedDuration(seconds)),
+			})
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to set time estimate: %w", err).Error()), nil
+		}
+
+		return timeStatsResult(stats)
+	}
+
+	return tool, handler
+}
+
+// AddSpentTime returns a tool for logging time spent on an issue or merge request
+func AddSpentTime(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"add_spent_time",
+		mcp.WithDescription(t("TOOL_ADD_SPENT_TIME_DESCRIPTION", "Log time spent on an issue or merge request. A negative duration subtracts time")),
+		withTimeTrackingTarget(t),
+		mcp.WithString("duration",
+			mcp.Required(),
+			mcp.Description(t("PARAM_SPENT_TIME_DURATION_DESCRIPTION", "The time spent, such as 1h30m, or -30m to subtract. A day is 8 hours and a week 5 days")),
+		),
+		mcp.WithString("summary",
+			mcp.Description(t("PARAM_SPENT_TIME_SUMMARY_DESCRIPTION", "What the time was spent on")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		projectID, id, mergeRequest, err := timeTrackingTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		duration, err := requiredParam[string](r, "duration")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		summary, err := OptionalParam[string](r, "summary")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		seconds, err := parseTrackedDuration(duration)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if seconds == 0 {
+			return mcp.NewToolResultError("duration must not be zero"), nil
+		}
+
+		opts := &gitlab.AddSpentTimeOptions{
+			Duration: gitlab.Ptr(formatTrackedDuration(seconds)),
+		}
+		if summary != "" {
+			opts.Summary = gitlab.Ptr(summary)
+		}
+
+		var stats *gitlab.TimeStats
+		if mergeRequest {
+			stats, _, err = client.MergeRequests.AddSpentTime(projectID, id, opts)
+		} else {
+			stats, _, err = client.Issues.AddSpentTime(projectID, id, opts)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to add spent time: %w", err).Error()), nil
+		}
+
+		return timeStatsResult(stats)
+	}
+
+	return tool, handler
+}
+
+// ResetSpentTime returns a tool for removing all time logged on an issue or merge request
+func ResetSpentTime(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"reset_spent_time",
+		mcp.WithDescription(t("TOOL_RESET_SPENT_TIME_DESCRIPTION", "Remove all time logged on an issue or merge request")),
+		withTimeTrackingTarget(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		projectID, id, mergeRequest, err := timeTrackingTarget(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var stats *gitlab.TimeStats
+		if mergeRequest {
+			stats, _, err = client.MergeRequests.ResetSpentTime(projectID, id)
+		} else {
+			stats, _, err = client.Issues.ResetSpentTime(projectID, id)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to reset spent time: %w", err).Error()), nil
+		}
+
+		return timeStatsResult(stats)
+	}
+
+	return tool, handler
+}
+
+// timeTotals sums the time tracked on a set of issues or merge requests
+type timeTotals struct {
+	Count               int    `json:"count"`
+	WithoutEstimate     int    `json:"without_estimate"`
+	TimeEstimate        int    `json:"time_estimate"`
+	TotalTimeSpent      int    `json:"total_time_spent"`
+	HumanTimeEstimate   string `json:"human_time_estimate"`
+	HumanTotalTimeSpent string `json:"human_total_time_spent"`
+}
+
+// add counts one item's time stats
+func (totals *timeTotals) add(stats *gitlab.TimeStats) {
+	totals.Count++
+	if stats == nil || stats.TimeEstimate == 0 {
+		totals.WithoutEstimate++
+	}
+	if stats != nil {
+		totals.TimeEstimate += stats.TimeEstimate
+		totals.TotalTimeSpent += stats.TotalTimeSpent
+	}
+	totals.HumanTimeEstimate = formatTrackedDuration(totals.TimeEstimate)
+	totals.HumanTotalTimeSpent = formatTrackedDuration(totals.TotalTimeSpent)
+}
+
+// assigneeTime is the tracked time of the items assigned to one user
+type assigneeTime struct {
+	Assignee string `json:"assignee"`
+	timeTotals
+}
+
+// overEstimateItem is an issue or merge request with more time spent than estimated
+type overEstimateItem struct {
+	Reference           string `json:"reference"`
+	Title               string `json:"title"`
+	WebURL              string `json:"web_url"`
+	HumanTimeEstimate   string `json:"human_time_estimate"`
+	HumanTotalTimeSpent string `json:"human_total_time_spent"`
+}
+
+// timeReport is the response of the time_report tool
+type timeReport struct {
+	Issues        timeTotals         `json:"issues"`
+	MergeRequests timeTotals         `json:"merge_requests"`
+	Total         timeTotals         `json:"total"`
+	ByAssignee    []*assigneeTime    `json:"by_assignee"`
+	OverEstimate  []overEstimateItem `json:"over_estimate"`
+	// Truncated lists issues or merge_requests when there were more than listAllPages reads, so the totals only cover those read
+	Truncated []string `json:"truncated,omitempty"`
+}
+
+// buildTimeReport sums the tracked time of issues and merge requests.
+// Items with several assignees count towards each of them, so the per-assignee totals can exceed the total.
+func buildTimeReport(issues []*gitlab.Issue, mrs []*gitlab.BasicMergeRequest) timeReport {
+	report := timeReport{
+		ByAssignee:   []*assigneeTime{},
+		OverEstimate: []overEstimateItem{},
+	}
+	byAssignee := map[string]*assigneeTime{}
+
+	add := func(stats *gitlab.TimeStats, assignees []string) {
+		report.Total.add(stats)
+		if len(assignees) == 0 {
+			assignees = []string{unassignedLabel}
+		}
+		for _, username := range assignees {
+			if byAssignee[username] == nil {
+				byAssignee[username] = &assigneeTime{Assignee: username}
+				report.ByAssignee = append(report.ByAssignee, byAssignee[username])
+			}
+			byAssignee[username].add(stats)
+		}
+	}
+	overEstimate := func(stats *gitlab.TimeStats, reference *gitlab.IssueReferences, title string, webURL string) {
+		if stats == nil || stats.TimeEstimate == 0 || stats.TotalTimeSpent <= stats.TimeEstimate {
+			return
+		}
+		item := overEstimateItem{
+			Title:               title,
+			WebURL:              webURL,
+			HumanTimeEstimate:   formatTrackedDuration(stats.TimeEstimate),
+			HumanTotalTimeSpent: formatTrackedDuration(stats.TotalTimeSpent),
+		}
+		if reference != nil {
+			item.Reference = reference.Full
+		}
+		report.OverEstimate = append(report.OverEstimate, item)
+	}
+
+	for _, issue := range issues {
+		report.Issues.add(issue.TimeStats)
+		var assignees []string
+		for _, a := range issue.Assignees {
+			assignees = append(assignees, a.Username)
+		}
+		add(issue.TimeStats, assignees)
+		overEstimate(issue.TimeStats, issue.References, issue.Title, issue.WebURL)
+	}
+
+	for _, mr := range mrs {
+		report.MergeRequests.add(mr.TimeStats)
+		var assignees []string
+		for _, a := range mr.Assignees {
+			assignees = append(assignees, a.Username)
+		}
+		add(mr.TimeStats, assignees)
+		overEstimate(mr.TimeStats, mr.References, mr.Title, mr.WebURL)
+	}
+
+	// empty sets still report their zero durations
+	for _, totals := range []*timeTotals{&report.Issues, &report.MergeRequests, &report.Total} {
+		totals.HumanTimeEstimate = formatTrackedDuration(totals.TimeEstimate)
+		totals.HumanTotalTimeSpent = formatTrackedDuration(totals.TotalTimeSpent)
+	}
+
+	sort.Slice(report.ByAssignee, func(i, j int) bool {
+		if report.ByAssignee[i].TotalTimeSpent != report.ByAssignee[j].TotalTimeSpent {
+			return report.ByAssignee[i].TotalTimeSpent > report.ByAssignee[j].TotalTimeSpent
+		}
+		return report.ByAssignee[i].Assignee < report.ByAssignee[j].Assignee
+	})
+
+	return report
+}
+
+// timeReportWork loads the issues and merge requests of a project or group matching a milestone and labels.
+// It also returns which of the two lists had more pages than were read.
+func timeReportWork(client *gitlab.Client, namespace string, project string, state *string, milestone *string, labels *gitlab.LabelOptions) ([]*gitlab.Issue, []*gitlab.BasicMergeRequest, []string, error) {
+	var truncated []string
+	pid := fmt.Sprintf("%s/%s", namespace, project)
+
+	issues, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.Issue, *gitlab.Response, error) {
+		if project != "" {
+			return client.Issues.ListProjectIssues(pid, &gitlab.ListProjectIssuesOptions{ListOptions: opts, State: state, Milestone: milestone, Labels: labels})
+		}
+		return client.Issues.ListGroupIssues(namespace, &gitlab.ListGroupIssuesOptions{ListOptions: opts, State: state, Milestone: milestone, Labels: labels})
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+	if morePages(resp) {
+		truncated = append(truncated, "issues")
+	}
+
+	// merge requests use merged instead of closed for completed work
+	mrState := state
+	if state != nil && *state == "closed" {
+		mrState = gitlab.Ptr("merged")
+	}
+
+	mrs, resp, err := listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		if project != "" {
+			return client.MergeRequests.ListProjectMergeRequests(pid, &gitlab.ListProjectMergeRequestsOptions{ListOptions: opts, State: mrState, Milestone: milestone, Labels: labels})
+		}
+		return client.MergeRequests.ListGroupMergeRequests(namespace, &gitlab.ListGroupMergeRequestsOptions{ListOptions: opts, State: mrState, Milestone: milestone, Labels: labels})
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list merge requests: %w", err)
+	}
+	if morePages(resp) {
+		truncated = append(truncated, "merge_requests")
+	}
+
+	return issues, mrs, truncated, nil
+}
+
+// TimeReport returns a tool for summing estimated and spent time across a milestone or label
+func TimeReport(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"time_report",
+		mcp.WithDescription(t("TOOL_TIME_REPORT_DESCRIPTION", "Sum the estimated and spent time of the issues and merge requests in a milestone or with given labels, per assignee, and list the items over their estimate")),
+		mcp.WithString("namespace",
+			mcp.Required(),
+			mcp.Description(t("PARAM_NAMESPACE_DESCRIPTION", "The namespace of the project")),
+		),
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_TIME_REPORT_PROJECT_DESCRIPTION", "The name of the project. Omit to report on the namespace group")),
+		),
+		mcp.WithString("milestone",
+			mcp.Description(t("PARAM_TIME_REPORT_MILESTONE_DESCRIPTION", "The title of the milestone to report on")),
+		),
+		mcp.WithArray("labels",
+			mcp.Description(t("PARAM_TIME_REPORT_LABELS_DESCRIPTION", "Only include items with all of these labels")),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+		),
+		mcp.WithString("state",
+			mcp.Description(t("PARAM_TIME_REPORT_STATE_DESCRIPTION", "Only include open or closed items. Defaults to all")),
+			mcp.Enum("opened", "closed", "all"),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		namespace, err := requiredParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := OptionalParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		milestoneTitle, err := OptionalParam[string](r, "milestone")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		labels, err := optionalLabelOptions(r, "labels")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		state, err := OptionalParam[string](r, "state")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if milestoneTitle == "" && (labels == nil || len(*labels) == 0) {
+			return mcp.NewToolResultError("either milestone or labels is required"), nil
+		}
+
+		if labels != nil && len(*labels) == 0 {
+			labels = nil
+		}
+		var milestone *string
+		if milestoneTitle != "" {
+			milestone = gitlab.Ptr(milestoneTitle)
+		}
+		if state == "" {
+			state = "all"
+		}
+
+		issues, mrs, truncated, err := timeReportWork(client, namespace, project, gitlab.Ptr(state), milestone, labels)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		report := buildTimeReport(issues, mrs)
+		report.Truncated = truncated
+
+		jsonData, err := json.Marshal(report)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
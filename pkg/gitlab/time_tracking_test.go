@@ -0,0 +1,234 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestParseTrackedDuration(t *testing.T) {
+	tests := []struct {
+		input         string
+		expected      int
+		expectedError string
+	}{
+		{input: "1h30m", expected: 5400},
+		{input: "1h 30m", expected: 5400},
+		{input: "2d 4h", expected: 20 * 3600},
+		{input: "1w", expected: 40 * 3600},
+		{input: "1mo", expected: 160 * 3600},
+		{input: "1.5h", expected: 5400},
+		{input: "3", expected: 3 * 3600},
+		{input: "-30m", expected: -1800},
+		{input: "45s", expected: 45},
+		{input: "", expectedError: `invalid duration ""`},
+		{input: "1x", expectedError: `invalid duration "1x"`},
+		{input: "h", expectedError: `invalid duration "h"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := parseTrackedDuration(tc.input)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestFormatTrackedDuration(t *testing.T) {
+	tests := []struct {
+		seconds  int
+		expected string
+	}{
+		{seconds: 0, expected: "0m"},
+		{seconds: 5400, expected: "1h 30m"},
+		{seconds: 47*3600 + 60, expected: "1w 7h 1m"},
+		{seconds: 160 * 3600, expected: "4w"},
+		{seconds: -1800, expected: "-30m"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expected, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formatTrackedDuration(tc.seconds))
+		})
+	}
+}
+
+func TestBuildTimeReport(t *testing.T) {
+	issues := []*gitlab.Issue{
+		{
+			Title:      "Over",
+			References: &gitlab.IssueReferences{Full: "group/project#1"},
+			Assignees:  []*gitlab.IssueAssignee{{Username: "alice"}},
+			TimeStats:  &gitlab.TimeStats{TimeEstimate: 3600, TotalTimeSpent: 7200},
+		},
+		{
+			Title:     "No estimate",
+			TimeStats: &gitlab.TimeStats{TotalTimeSpent: 1800},
+		},
+	}
+	mrs := []*gitlab.BasicMergeRequest{
+		{
+			Title:     "Under",
+			Assignees: []*gitlab.BasicUser{{Username: "alice"}, {Username: "bob"}},
+			TimeStats: &gitlab.TimeStats{TimeEstimate: 7200, TotalTimeSpent: 3600},
+		},
+	}
+
+	report := buildTimeReport(issues, mrs)
+
+	assert.Equal(t, timeTotals{Count: 2, WithoutEstimate: 1, TimeEstimate: 3600, TotalTimeSpent: 9000, HumanTimeEstimate: "1h", HumanTotalTimeSpent: "2h 30m"}, report.Issues)
+	assert.Equal(t, timeTotals{Count: 1, TimeEstimate: 7200, TotalTimeSpent: 3600, HumanTimeEstimate: "2h", HumanTotalTimeSpent: "1h"}, report.MergeRequests)
+	assert.Equal(t, timeTotals{Count: 3, WithoutEstimate: 1, TimeEstimate: 10800, TotalTimeSpent: 12600, HumanTimeEstimate: "3h", HumanTotalTimeSpent: "3h 30m"}, report.Total)
+
+	require.Len(t, report.ByAssignee, 3)
+	assert.Equal(t, "alice", report.ByAssignee[0].Assignee)
+	assert.Equal(t, 10800, report.ByAssignee[0].TotalTimeSpent)
+	assert.Equal(t, "bob", report.ByAssignee[1].Assignee)
+	assert.Equal(t, unassignedLabel, report.ByAssignee[2].Assignee)
+
+	assert.Equal(t, []overEstimateItem{{Reference: "group/project#1", Title: "Over", HumanTimeEstimate: "1h", HumanTotalTimeSpent: "2h"}}, report.OverEstimate)
+}
+
+func TestTimeReportTruncated(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "v1.0", r.URL.Query().Get("milestone"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v4/projects/group/project/issues":
+			// every page points to a next one, so only maxListPages pages are read
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			if page == 0 {
+				page = 1
+			}
+			w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+			fmt.Fprintf(w, `[{"id":%d,"iid":%d,"time_stats":{"time_estimate":3600,"total_time_spent":1800}}]`, page, page)
+		case "/api/v4/projects/group/project/merge_requests":
+			fmt.Fprint(w, `[{"iid":1,"time_stats":{"time_estimate":600,"total_time_spent":600}}]`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := TimeReport(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"namespace": "group",
+		"project":   "project",
+		"milestone": "v1.0",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var got timeReport
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.Equal(t, []string{"issues"}, got.Truncated)
+	assert.Equal(t, maxListPages, got.Issues.Count)
+	assert.Equal(t, 1, got.MergeRequests.Count)
+}
+
+func TestAddSpentTime(t *testing.T) {
+	tests := []struct {
+		name             string
+		args             map[string]interface{}
+		expectedDuration string
+		expectedError    string
+	}{
+		{
+			name: "human duration normalized",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"id":        float64(1),
+				"duration":  "1h30m",
+				"summary":   "Investigation",
+			},
+			expectedDuration: "1h 30m",
+		},
+		{
+			name: "invalid duration",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"id":        float64(1),
+				"duration":  "an hour",
+			},
+			expectedError: `invalid duration "an hour"`,
+		},
+		{
+			name: "invalid item type",
+			args: map[string]interface{}{
+				"namespace": "test-namespace",
+				"project":   "test-project",
+				"id":        float64(1),
+				"duration":  "1h",
+				"item_type": "epic",
+			},
+			expectedError: "parameter item_type must be issue or merge_request, got epic",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var added *gitlab.AddSpentTimeOptions
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Issues: &mockIssuesService{
+						addSpentFunc: func(pid interface{}, issue int, opt *gitlab.AddSpentTimeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error) {
+							assert.Equal(t, "test-namespace/test-project", pid)
+							added = opt
+							return &gitlab.TimeStats{TotalTimeSpent: 5400, HumanTotalTimeSpent: "1h 30m"}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := AddSpentTime(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got gitlab.TimeStats
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, 5400, got.TotalTimeSpent)
+			require.NotNil(t, added)
+			assert.Equal(t, tc.expectedDuration, *added.Duration)
+			assert.Equal(t, "Investigation", *added.Summary)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// todoSummary is a to-do item with the fields needed to decide what to look at
+type todoSummary struct {
+	ID         int        `json:"id"`
+	Action     string     `json:"action"`
+	TargetType string     `json:"target_type"`
+	Title      string     `json:"title"`
+	URL        string     `json:"url"`
+	Project    string     `json:"project,omitempty"`
+	Author     string     `json:"author,omitempty"`
+	AuthorName string     `json:"author_name,omitempty"`
+	Body       string     `json:"body,omitempty"`
+	State      string     `json:"state"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// summarizeTodo flattens a to-do item. Author is the user whose action created the to-do.
+func summarizeTodo(todo *gitlab.Todo) todoSummary {
+	summary := todoSummary{
+		ID:         todo.ID,
+		Action:     string(todo.ActionName),
+		TargetType: string(todo.TargetType),
+		URL:        todo.TargetURL,
+		Body:       todo.Body,
+		State:      todo.State,
+		CreatedAt:  todo.CreatedAt,
+	}
+	if todo.Target != nil {
+		summary.Title = todo.Target.Title
+	}
+	if todo.Project != nil {
+		summary.Project = todo.Project.PathWithNamespace
+	}
+	if todo.Author != nil {
+		summary.Author = todo.Author.Username
+		summary.AuthorName = todo.Author.Name
+	}
+	return summary
+}
+
+// ListTodos returns a tool for listing the to-do items of the authenticated user
+func ListTodos(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_todos",
+		mcp.WithDescription(t("TOOL_LIST_TODOS_DESCRIPTION", "List the to-do items of the authenticated user with the title and URL of their target and who triggered them")),
+		mcp.WithString("action",
+			mcp.Description(t("PARAM_TODO_ACTION_DESCRIPTION", "Only return to-do items created by this action")),
+			mcp.Enum("assigned", "mentioned", "build_failed", "marked", "approval_required", "unmergeable", "directly_addressed", "merge_train_removed", "member_access_requested"),
+		),
+		mcp.WithString("type",
+			mcp.Description(t("PARAM_TODO_TYPE_DESCRIPTION", "Only return to-do items on this type of target")),
+			mcp.Enum("Issue", "MergeRequest", "Commit", "Epic", "DesignManagement::Design", "AlertManagement::Alert"),
+		),
+		mcp.WithString("namespace",
+			mcp.Description(t("PARAM_TODO_NAMESPACE_DESCRIPTION", "The namespace of the project to filter by. Requires project")),
+		),
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_TODO_PROJECT_DESCRIPTION", "The name of the project to filter by. Requires namespace")),
+		),
+		mcp.WithString("state",
+			mcp.Description(t("PARAM_TODO_STATE_DESCRIPTION", "Only return pending or done to-do items. Defaults to pending")),
+			mcp.Enum("pending", "done"),
+		),
+		withPagination(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		action, err := OptionalParam[string](r, "action")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		targetType, err := OptionalParam[string](r, "type")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		namespace, err := OptionalParam[string](r, "namespace")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		project, err := OptionalParam[string](r, "project")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		state, err := OptionalParam[string](r, "state")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pagination, err := OptionalPaginationParams(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if (namespace == "") != (project == "") {
+			return mcp.NewToolResultError("namespace and project must be given together"), nil
+		}
+
+		opts := &gitlab.ListTodosOptions{ListOptions: pagination}
+		if action != "" {
+			opts.Action = gitlab.Ptr(gitlab.TodoAction(action))
+		}
+		if targetType != "" {
+			opts.Type = gitlab.Ptr(targetType)
+		}
+		if state != "" {
+			opts.State = gitlab.Ptr(state)
+		}
+		if project != "" {
+			// the to-do API filters by numeric project ID only
+			p, _, err := client.Projects.GetProject(fmt.Sprintf("%s/%s", namespace, project), nil)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to get project: %w", err).Error()), nil
+			}
+			opts.ProjectID = gitlab.Ptr(p.ID)
+		}
+
+		todos, _, err := client.Todos.ListTodos(opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to list todos: %w", err).Error()), nil
+		}
+
+		summaries := make([]todoSummary, 0, len(todos))
+		for _, todo := range todos {
+			summaries = append(summaries, summarizeTodo(todo))
+		}
+
+		jsonData, err := json.Marshal(summaries)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(jsonData)), nil
+	}
+
+	return tool, handler
+}
+
+// MarkTodoDone returns a tool for marking a to-do item as done
+func MarkTodoDone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"mark_todo_done",
+		mcp.WithDescription(t("TOOL_MARK_TODO_DONE_DESCRIPTION", "Mark a to-do item of the authenticated user as done")),
+		mcp.WithNumber("todo_id",
+			mcp.Required(),
+			mcp.Description(t("PARAM_TODO_ID_DESCRIPTION", "The ID of the to-do item, as returned by list_todos")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		id, err := RequiredInt(r, "todo_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if _, err := client.Todos.MarkTodoAsDone(id); err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to mark todo as done: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("To-do %d marked as done", id)), nil
+	}
+
+	return tool, handler
+}
+
+// MarkAllTodosDone returns a tool for marking every pending to-do item as done
+func MarkAllTodosDone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"mark_all_todos_done",
+		mcp.WithDescription(t("TOOL_MARK_ALL_TODOS_DONE_DESCRIPTION", "Mark all pending to-do items of the authenticated user as done")),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		if _, err := client.Todos.MarkAllTodosAsDone(); err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to mark all todos as done: %w", err).Error()), nil
+		}
+
+		return mcp.NewToolResultText("All to-dos marked as done"), nil
+	}
+
+	return tool, handler
+}
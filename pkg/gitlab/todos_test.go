@@ -0,0 +1,155 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockTodosService is a mock implementation of the GitLab todos service
+type mockTodosService struct {
+	listFunc     func(opt *gitlab.ListTodosOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Todo, *gitlab.Response, error)
+	markDoneFunc func(id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// ensure mockTodosService implements the gitlab.TodosServiceInterface
+var _ gitlab.TodosServiceInterface = &mockTodosService{}
+
+func (m *mockTodosService) ListTodos(opt *gitlab.ListTodosOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Todo, *gitlab.Response, error) {
+	return m.listFunc(opt, options...)
+}
+
+func (m *mockTodosService) MarkTodoAsDone(id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return m.markDoneFunc(id, options...)
+}
+
+func (m *mockTodosService) MarkAllTodosAsDone(options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func TestListTodos(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]interface{}
+		mockResponse   []*gitlab.Todo
+		mockError      error
+		expectedAction *gitlab.TodoAction
+		expected       []todoSummary
+		expectedError  string
+	}{
+		{
+			name: "todos summarized",
+			args: map[string]interface{}{
+				"action": "mentioned",
+			},
+			mockResponse: []*gitlab.Todo{
+				{
+					ID:         7,
+					ActionName: gitlab.TodoMentioned,
+					TargetType: gitlab.TodoTargetMergeRequest,
+					Target:     &gitlab.TodoTarget{Title: "Add caching"},
+					TargetURL:  "https://gitlab.example.com/group/project/-/merge_requests/3",
+					Project:    &gitlab.BasicProject{PathWithNamespace: "group/project"},
+					Author:     &gitlab.BasicUser{Username: "alice", Name: "Alice"},
+					State:      "pending",
+				},
+			},
+			expectedAction: gitlab.Ptr(gitlab.TodoMentioned),
+			expected: []todoSummary{
+				{
+					ID:         7,
+					Action:     "mentioned",
+					TargetType: "MergeRequest",
+					Title:      "Add caching",
+					URL:        "https://gitlab.example.com/group/project/-/merge_requests/3",
+					Project:    "group/project",
+					Author:     "alice",
+					AuthorName: "Alice",
+					State:      "pending",
+				},
+			},
+		},
+		{
+			name: "project without namespace",
+			args: map[string]interface{}{
+				"project": "test-project",
+			},
+			expectedError: "namespace and project must be given together",
+		},
+		{
+			name:          "GitLab API error",
+			args:          map[string]interface{}{},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to list todos: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					Todos: &mockTodosService{
+						listFunc: func(opt *gitlab.ListTodosOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Todo, *gitlab.Response, error) {
+							assert.Equal(t, tc.expectedAction, opt.Action)
+							return tc.mockResponse, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListTodos(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+
+			var got []todoSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestMarkTodoDone(t *testing.T) {
+	var marked int
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return &gitlab.Client{
+			Todos: &mockTodosService{
+				markDoneFunc: func(id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					marked = id
+					return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+				},
+			},
+		}, nil
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := MarkTodoDone(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{"todo_id": float64(7)}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	assert.False(t, result.IsError)
+	assert.Equal(t, "To-do 7 marked as done", textContent.Text)
+	assert.Equal(t, 7, marked)
+}
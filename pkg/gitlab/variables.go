@@ -0,0 +1,572 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jbendotnet/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ciVariable is a CI/CD variable of any level. Value is only set when it was asked for and the variable is neither masked nor hidden.
+type ciVariable struct {
+	Key              string `json:"key"`
+	VariableType     string `json:"variable_type"`
+	EnvironmentScope string `json:"environment_scope,omitempty"`
+	Protected        bool   `json:"protected"`
+	Masked           bool   `json:"masked"`
+	Hidden           bool   `json:"hidden,omitempty"`
+	Raw              bool   `json:"raw"`
+	Description      string `json:"description,omitempty"`
+	Value            string `json:"value,omitempty"`
+	// ValueWithheld is set when the value was asked for but the variable is masked or hidden
+	ValueWithheld bool `json:"value_withheld,omitempty"`
+}
+
+// setValue sets the value of the variable if it was asked for and may be shown.
+// Masked values are secrets and never leave the server.
+func (v *ciVariable) setValue(value string, include bool) {
+	if !include {
+		return
+	}
+	if v.Masked || v.Hidden {
+		v.ValueWithheld = true
+		return
+	}
+	v.Value = value
+}
+
+// fromProjectVariable converts a project variable
+func fromProjectVariable(variable *gitlab.ProjectVariable, includeValue bool) ciVariable {
+	v := ciVariable{
+		Key:              variable.Key,
+		VariableType:     string(variable.VariableType),
+		EnvironmentScope: variable.EnvironmentScope,
+		Protected:        variable.Protected,
+		Masked:           variable.Masked,
+		Hidden:           variable.Hidden,
+		Raw:              variable.Raw,
+		Description:      variable.Description,
+	}
+	v.setValue(variable.Value, includeValue)
+	return v
+}
+
+// fromGroupVariable converts a group variable
+func fromGroupVariable(variable *gitlab.GroupVariable, includeValue bool) ciVariable {
+	v := ciVariable{
+		Key:              variable.Key,
+		VariableType:     string(variable.VariableType),
+		EnvironmentScope: variable.EnvironmentScope,
+		Protected:        variable.Protected,
+		Masked:           variable.Masked,
+		Hidden:           variable.Hidden,
+		Raw:              variable.Raw,
+		Description:      variable.Description,
+	}
+	v.setValue(variable.Value, includeValue)
+	return v
+}
+
+// fromInstanceVariable converts an instance variable, which has no environment scope
+func fromInstanceVariable(variable *gitlab.InstanceVariable, includeValue bool) ciVariable {
+	v := ciVariable{
+		Key:          variable.Key,
+		VariableType: string(variable.VariableType),
+		Protected:    variable.Protected,
+		Masked:       variable.Masked,
+		Raw:          variable.Raw,
+		Description:  variable.Description,
+	}
+	v.setValue(variable.Value, includeValue)
+	return v
+}
+
+// ciVariableList is the response of list_ci_variables
+type ciVariableList struct {
+	Variables []ciVariable `json:"variables"`
+	// Truncated is set when the level has more variables than listAllPages reads
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// withVariableTarget adds the parameters selecting the level of the variables.
+// Tools that change variables never default to the instance, so their description says so.
+func withVariableTarget(t translations.TranslationHelperFunc, write bool) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		levelDescription := t("PARAM_VARIABLE_LEVEL_DESCRIPTION", "Whether the variables belong to a project, a group or the instance. Defaults to project when project is given, group when only namespace is given and instance otherwise. Instance variables need administrator access")
+		if write {
+			levelDescription = t("PARAM_VARIABLE_WRITE_LEVEL_DESCRIPTION", "Whether the variable belongs to a project, a group or the instance. Defaults to project when project is given and group when only namespace is given. Must be set to instance to change instance variables, which need administrator access")
+		}
+		mcp.WithString("level",
+			mcp.Description(levelDescription),
+			mcp.Enum("project", "group", "instance"),
+		)(tool)
+		mcp.WithString("namespace",
+			mcp.Description(t("PARAM_VARIABLE_NAMESPACE_DESCRIPTION", "The namespace of the project, or the group")),
+		)(tool)
+		mcp.WithString("project",
+			mcp.Description(t("PARAM_VARIABLE_PROJECT_DESCRIPTION", "The name of the project. Requires namespace")),
+		)(tool)
+	}
+}
+
+// readVariableTarget reads the parameters added by withVariableTarget.
+// Tools that change variables must set level to instance explicitly, so a call missing its namespace never changes instance variables.
+func readVariableTarget(r mcp.CallToolRequest, write bool) (levelTarget, error) {
+	return readLevelTarget(r, "variables", !write)
+}
+
+// variableSettings are the optional attributes of a variable given to create_ci_variable or update_ci_variable
+type variableSettings struct {
+	value        *string
+	description  *string
+	variableType *gitlab.VariableTypeValue
+	protected    *bool
+	masked       *bool
+	raw          *bool
+}
+
+// withVariableSettings adds the parameters of variableSettings except value
+func withVariableSettings(t translations.TranslationHelperFunc) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("description",
+			mcp.Description(t("PARAM_VARIABLE_DESCRIPTION_DESCRIPTION", "The description of the variable")),
+		)(tool)
+		mcp.WithString("variable_type",
+			mcp.Description(t("PARAM_VARIABLE_TYPE_DESCRIPTION", "Whether the variable is an environment variable or a file")),
+			mcp.Enum("env_var", "file"),
+		)(tool)
+		mcp.WithBoolean("protected",
+			mcp.Description(t("PARAM_VARIABLE_PROTECTED_DESCRIPTION", "Only expose the variable to pipelines of protected branches and tags")),
+		)(tool)
+		mcp.WithBoolean("masked",
+			mcp.Description(t("PARAM_VARIABLE_MASKED_DESCRIPTION", "Mask the value in job logs. The value must meet GitLab's masking requirements")),
+		)(tool)
+		mcp.WithBoolean("raw",
+			mcp.Description(t("PARAM_VARIABLE_RAW_DESCRIPTION", "Do not expand variable references in the value")),
+		)(tool)
+	}
+}
+
+// readVariableSettings reads the value and the parameters added by withVariableSettings, leaving the missing ones nil
+func readVariableSettings(r mcp.CallToolRequest) (variableSettings, error) {
+	var settings variableSettings
+	var err error
+	if settings.value, err = optionalPtrParam[string](r, "value"); err != nil {
+		return settings, err
+	}
+	if settings.description, err = optionalPtrParam[string](r, "description"); err != nil {
+		return settings, err
+	}
+	variableType, err := OptionalParam[string](r, "variable_type")
+	if err != nil {
+		return settings, err
+	}
+	if variableType != "" {
+		if variableType != "env_var" && variableType != "file" {
+			return settings, fmt.Errorf("parameter variable_type must be env_var or file, got %s", variableType)
+		}
+		settings.variableType = gitlab.Ptr(gitlab.VariableTypeValue(variableType))
+	}
+	for name, setting := range map[string]**bool{
+		"protected": &settings.protected,
+		"masked":    &settings.masked,
+		"raw":       &settings.raw,
+	} {
+		if *setting, err = optionalPtrParam[bool](r, name); err != nil {
+			return settings, err
+		}
+	}
+	return settings, nil
+}
+
+// variableMasked reports whether an existing variable is masked or hidden
+func variableMasked(client *gitlab.Client, target levelTarget, key string, filter *gitlab.VariableFilter) (bool, error) {
+	switch target.level {
+	case "project":
+		variable, _, err := client.ProjectVariables.GetVariable(target.path, key, &gitlab.GetProjectVariableOptions{Filter: filter})
+		if err != nil {
+			return false, fmt.Errorf("failed to get project variable: %w", err)
+		}
+		return variable.Masked || variable.Hidden, nil
+	case "group":
+		variable, _, err := client.GroupVariables.GetVariable(target.path, key, &gitlab.GetGroupVariableOptions{Filter: filter})
+		if err != nil {
+			return false, fmt.Errorf("failed to get group variable: %w", err)
+		}
+		return variable.Masked || variable.Hidden, nil
+	default:
+		variable, _, err := client.InstanceVariables.GetVariable(key)
+		if err != nil {
+			return false, fmt.Errorf("failed to get instance variable: %w", err)
+		}
+		return variable.Masked, nil
+	}
+}
+
+// variableResult marshals variables as the tool response
+func variableResult(v interface{}) (*mcp.CallToolResult, error) {
+	jsonData, err := json.Marshal(v)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Errorf("failed to marshal response: %w", err).Error()), nil
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// ListCIVariables returns a tool for listing the CI/CD variables of a project, a group or the instance
+func ListCIVariables(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"list_ci_variables",
+		mcp.WithDescription(t("TOOL_LIST_CI_VARIABLES_DESCRIPTION", "List the CI/CD variables of a project, a group or the instance with their scope and flags. Values are left out unless include_values is set, and values of masked or hidden variables are never returned")),
+		withVariableTarget(t, false),
+		mcp.WithString("environment_scope",
+			mcp.Description(t("PARAM_VARIABLE_ENVIRONMENT_SCOPE_FILTER_DESCRIPTION", "Only return variables with exactly this environment scope, such as * or production. Not available for instance variables")),
+		),
+		mcp.WithBoolean("include_values",
+			mcp.Description(t("PARAM_VARIABLE_INCLUDE_VALUES_DESCRIPTION", "Return the values of variables that are neither masked nor hidden")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		target, err := readVariableTarget(r, false)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		environmentScope, err := OptionalParam[string](r, "environment_scope")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		includeValues, err := OptionalParam[bool](r, "include_values")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if environmentScope != "" && target.level == "instance" {
+			return mcp.NewToolResultError("instance variables have no environment scope"), nil
+		}
+
+		variables := []ciVariable{}
+		var resp *gitlab.Response
+		switch target.level {
+		case "project":
+			var list []*gitlab.ProjectVariable
+			list, resp, err = listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+				listOpts := gitlab.ListProjectVariablesOptions(opts)
+				return client.ProjectVariables.ListVariables(target.path, &listOpts)
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to list project variables: %w", err).Error()), nil
+			}
+			for _, variable := range list {
+				variables = append(variables, fromProjectVariable(variable, includeValues))
+			}
+		case "group":
+			var list []*gitlab.GroupVariable
+			list, resp, err = listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+				listOpts := gitlab.ListGroupVariablesOptions(opts)
+				return client.GroupVariables.ListVariables(target.path, &listOpts)
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to list group variables: %w", err).Error()), nil
+			}
+			for _, variable := range list {
+				variables = append(variables, fromGroupVariable(variable, includeValues))
+			}
+		default:
+			var list []*gitlab.InstanceVariable
+			list, resp, err = listAllPages(func(opts gitlab.ListOptions) ([]*gitlab.InstanceVariable, *gitlab.Response, error) {
+				listOpts := gitlab.ListInstanceVariablesOptions(opts)
+				return client.InstanceVariables.ListVariables(&listOpts)
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to list instance variables: %w", err).Error()), nil
+			}
+			for _, variable := range list {
+				variables = append(variables, fromInstanceVariable(variable, includeValues))
+			}
+		}
+
+		if environmentScope != "" {
+			filtered := []ciVariable{}
+			for _, variable := range variables {
+				if variable.EnvironmentScope == environmentScope {
+					filtered = append(filtered, variable)
+				}
+			}
+			variables = filtered
+		}
+
+		return variableResult(ciVariableList{Variables: variables, Truncated: morePages(resp)})
+	}
+
+	return tool, handler
+}
+
+// CreateCIVariable returns a tool for creating a CI/CD variable
+func CreateCIVariable(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"create_ci_variable",
+		mcp.WithDescription(t("TOOL_CREATE_CI_VARIABLE_DESCRIPTION", "Create a CI/CD variable in a project, a group or the instance. The value is not echoed back")),
+		withVariableTarget(t, true),
+		mcp.WithString("key",
+			mcp.Required(),
+			mcp.Description(t("PARAM_VARIABLE_KEY_DESCRIPTION", "The key of the variable")),
+		),
+		mcp.WithString("value",
+			mcp.Required(),
+			mcp.Description(t("PARAM_VARIABLE_VALUE_DESCRIPTION", "The value of the variable")),
+		),
+		mcp.WithString("environment_scope",
+			mcp.Description(t("PARAM_VARIABLE_ENVIRONMENT_SCOPE_DESCRIPTION", "The environments the variable applies to, such as production or review/*. Defaults to all environments. Not available for instance variables")),
+		),
+		withVariableSettings(t),
+		mcp.WithBoolean("hidden",
+			mcp.Description(t("PARAM_VARIABLE_HIDDEN_DESCRIPTION", "Mask the variable and hide its value in the UI for good. Not available for instance variables")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		target, err := readVariableTarget(r, true)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		key, err := requiredParam[string](r, "key")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if _, err := requiredParam[string](r, "value"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		environmentScope, err := OptionalParam[string](r, "environment_scope")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		hidden, err := OptionalParam[bool](r, "hidden")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		settings, err := readVariableSettings(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if target.level == "instance" && (environmentScope != "" || hidden) {
+			return mcp.NewToolResultError("instance variables have no environment scope and cannot be hidden"), nil
+		}
+
+		var scope *string
+		if environmentScope != "" {
+			scope = gitlab.Ptr(environmentScope)
+		}
+		var maskedAndHidden *bool
+		if hidden {
+			maskedAndHidden = gitlab.Ptr(true)
+			settings.masked = gitlab.Ptr(true)
+		}
+
+		var created ciVariable
+		switch target.level {
+		case "project":
+			variable, _, err := client.ProjectVariables.CreateVariable(target.path, &gitlab.CreateProjectVariableOptions{
+				Key: gitlab.Ptr(key), Value: settings.value, Description: settings.description, EnvironmentScope: scope,
+				Masked: settings.masked, MaskedAndHidden: maskedAndHidden, Protected: settings.protected, Raw: settings.raw, VariableType: settings.variableType,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to create project variable: %w", err).Error()), nil
+			}
+			created = fromProjectVariable(variable, false)
+		case "group":
+			variable, _, err := client.GroupVariables.CreateVariable(target.path, &gitlab.CreateGroupVariableOptions{
+				Key: gitlab.Ptr(key), Value: settings.value, Description: settings.description, EnvironmentScope: scope,
+				Masked: settings.masked, MaskedAndHidden: maskedAndHidden, Protected: settings.protected, Raw: settings.raw, VariableType: settings.variableType,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to create group variable: %w", err).Error()), nil
+			}
+			created = fromGroupVariable(variable, false)
+		default:
+			variable, _, err := client.InstanceVariables.CreateVariable(&gitlab.CreateInstanceVariableOptions{
+				Key: gitlab.Ptr(key), Value: settings.value, Description: settings.description,
+				Masked: settings.masked, Protected: settings.protected, Raw: settings.raw, VariableType: settings.variableType,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to create instance variable: %w", err).Error()), nil
+			}
+			created = fromInstanceVariable(variable, false)
+		}
+
+		return variableResult(created)
+	}
+
+	return tool, handler
+}
+
+// UpdateCIVariable returns a tool for updating a CI/CD variable
+func UpdateCIVariable(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"update_ci_variable",
+		mcp.WithDescription(t("TOOL_UPDATE_CI_VARIABLE_DESCRIPTION", "Update the value or settings of a CI/CD variable in a project, a group or the instance. Only the given settings change and the value is not echoed back. A masked variable can only be unmasked together with a new value")),
+		withVariableTarget(t, true),
+		mcp.WithString("key",
+			mcp.Required(),
+			mcp.Description(t("PARAM_VARIABLE_KEY_DESCRIPTION", "The key of the variable")),
+		),
+		mcp.WithString("value",
+			mcp.Description(t("PARAM_VARIABLE_NEW_VALUE_DESCRIPTION", "The new value of the variable")),
+		),
+		mcp.WithString("environment_scope",
+			mcp.Description(t("PARAM_VARIABLE_ENVIRONMENT_SCOPE_SELECT_DESCRIPTION", "The environment scope of the variable to change, when the key is defined for several scopes")),
+		),
+		withVariableSettings(t),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		target, err := readVariableTarget(r, true)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		key, err := requiredParam[string](r, "key")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		environmentScope, err := OptionalParam[string](r, "environment_scope")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		settings, err := readVariableSettings(r)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if environmentScope != "" && target.level == "instance" {
+			return mcp.NewToolResultError("instance variables have no environment scope"), nil
+		}
+
+		var filter *gitlab.VariableFilter
+		if environmentScope != "" {
+			filter = &gitlab.VariableFilter{EnvironmentScope: environmentScope}
+		}
+
+		// Unmasking keeps the old value, which list_ci_variables would then return,
+		// so a masked secret may only be unmasked together with a new value
+		if settings.masked != nil && !*settings.masked && settings.value == nil {
+			masked, err := variableMasked(client, target, key, filter)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if masked {
+				return mcp.NewToolResultError(fmt.Sprintf("variable %s is masked; give a new value to unmask it, so the masked value is never revealed", key)), nil
+			}
+		}
+
+		var updated ciVariable
+		switch target.level {
+		case "project":
+			variable, _, err := client.ProjectVariables.UpdateVariable(target.path, key, &gitlab.UpdateProjectVariableOptions{
+				Value: settings.value, Description: settings.description, Filter: filter,
+				Masked: settings.masked, Protected: settings.protected, Raw: settings.raw, VariableType: settings.variableType,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to update project variable: %w", err).Error()), nil
+			}
+			updated = fromProjectVariable(variable, false)
+		case "group":
+			variable, _, err := client.GroupVariables.UpdateVariable(target.path, key, &gitlab.UpdateGroupVariableOptions{
+				Value: settings.value, Description: settings.description, Filter: filter,
+				Masked: settings.masked, Protected: settings.protected, Raw: settings.raw, VariableType: settings.variableType,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to update group variable: %w", err).Error()), nil
+			}
+			updated = fromGroupVariable(variable, false)
+		default:
+			variable, _, err := client.InstanceVariables.UpdateVariable(key, &gitlab.UpdateInstanceVariableOptions{
+				Value: settings.value, Description: settings.description,
+				Masked: settings.masked, Protected: settings.protected, Raw: settings.raw, VariableType: settings.variableType,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Errorf("failed to update instance variable: %w", err).Error()), nil
+			}
+			updated = fromInstanceVariable(variable, false)
+		}
+
+		return variableResult(updated)
+	}
+
+	return tool, handler
+}
+
+// DeleteCIVariable returns a tool for deleting a CI/CD variable
+func DeleteCIVariable(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool(
+		"delete_ci_variable",
+		mcp.WithDescription(t("TOOL_DELETE_CI_VARIABLE_DESCRIPTION", "Delete a CI/CD variable from a project, a group or the instance")),
+		withVariableTarget(t, true),
+		mcp.WithString("key",
+			mcp.Required(),
+			mcp.Description(t("PARAM_VARIABLE_KEY_DESCRIPTION", "The key of the variable")),
+		),
+		mcp.WithString("environment_scope",
+			mcp.Description(t("PARAM_VARIABLE_ENVIRONMENT_SCOPE_SELECT_DESCRIPTION", "The environment scope of the variable to delete, when the key is defined for several scopes")),
+		),
+	)
+
+	handler = func(ctx context.Context, r mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to get GitLab client: %w", err).Error()), nil
+		}
+
+		target, err := readVariableTarget(r, true)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		key, err := requiredParam[string](r, "key")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		environmentScope, err := OptionalParam[string](r, "environment_scope")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if environmentScope != "" && target.level == "instance" {
+			return mcp.NewToolResultError("instance variables have no environment scope"), nil
+		}
+
+		var filter *gitlab.VariableFilter
+		if environmentScope != "" {
+			filter = &gitlab.VariableFilter{EnvironmentScope: environmentScope}
+		}
+
+		switch target.level {
+		case "project":
+			_, err = client.ProjectVariables.RemoveVariable(target.path, key, &gitlab.RemoveProjectVariableOptions{Filter: filter})
+		case "group":
+			_, err = client.GroupVariables.RemoveVariable(target.path, key, &gitlab.RemoveGroupVariableOptions{Filter: filter})
+		default:
+			_, err = client.InstanceVariables.RemoveVariable(key)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Errorf("failed to delete %s variable: %w", target.level, err).Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Variable %s deleted", key)), nil
+	}
+
+	return tool, handler
+}
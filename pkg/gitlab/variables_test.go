@@ -0,0 +1,501 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mockProjectVariablesService is a mock implementation of the GitLab project variables service
+type mockProjectVariablesService struct {
+	listFunc   func(pid interface{}, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error)
+	createFunc func(pid interface{}, opt *gitlab.CreateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error)
+	removeFunc func(pid interface{}, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	getFunc    func(pid interface{}, key string, opt *gitlab.GetProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error)
+	updateFunc func(pid interface{}, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error)
+}
+
+// ensure mockProjectVariablesService implements the gitlab.ProjectVariablesServiceInterface
+var _ gitlab.ProjectVariablesServiceInterface = &mockProjectVariablesService{}
+
+func (m *mockProjectVariablesService) ListVariables(pid interface{}, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+	return m.listFunc(pid, opt, options...)
+}
+
+func (m *mockProjectVariablesService) CreateVariable(pid interface{}, opt *gitlab.CreateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	return m.createFunc(pid, opt, options...)
+}
+
+func (m *mockProjectVariablesService) GetVariable(pid interface{}, key string, opt *gitlab.GetProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	return m.getFunc(pid, key, opt, options...)
+}
+
+func (m *mockProjectVariablesService) RemoveVariable(pid interface{}, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return m.removeFunc(pid, key, opt, options...)
+}
+
+func (m *mockProjectVariablesService) UpdateVariable(pid interface{}, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	return m.updateFunc(pid, key, opt, options...)
+}
+
+// mockGroupVariablesService is a mock implementation of the GitLab group variables service
+type mockGroupVariablesService struct {
+	listFunc func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error)
+}
+
+// ensure mockGroupVariablesService implements the gitlab.GroupVariablesServiceInterface
+var _ gitlab.GroupVariablesServiceInterface = &mockGroupVariablesService{}
+
+func (m *mockGroupVariablesService) ListVariables(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+	return m.listFunc(gid, opt, options...)
+}
+
+func (m *mockGroupVariablesService) CreateVariable(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockGroupVariablesService) GetVariable(gid interface{}, key string, opt *gitlab.GetGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockGroupVariablesService) RemoveVariable(gid interface{}, key string, opt *gitlab.RemoveGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return nil, nil
+}
+
+func (m *mockGroupVariablesService) UpdateVariable(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+// mockInstanceVariablesService is a mock implementation of the GitLab instance variables service
+type mockInstanceVariablesService struct {
+	removeFunc func(key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// ensure mockInstanceVariablesService implements the gitlab.InstanceVariablesServiceInterface
+var _ gitlab.InstanceVariablesServiceInterface = &mockInstanceVariablesService{}
+
+func (m *mockInstanceVariablesService) ListVariables(opt *gitlab.ListInstanceVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.InstanceVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockInstanceVariablesService) GetVariable(key string, options ...gitlab.RequestOptionFunc) (*gitlab.InstanceVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockInstanceVariablesService) CreateVariable(opt *gitlab.CreateInstanceVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.InstanceVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockInstanceVariablesService) UpdateVariable(key string, opt *gitlab.UpdateInstanceVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.InstanceVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func (m *mockInstanceVariablesService) RemoveVariable(key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return m.removeFunc(key, options...)
+}
+
+func TestListCIVariables(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+	projectVariables := []*gitlab.ProjectVariable{
+		{Key: "LOG_LEVEL", Value: "debug", VariableType: gitlab.EnvVariableType, EnvironmentScope: "*"},
+		{Key: "DEPLOY_TOKEN", Value: "s3cr3t-token", VariableType: gitlab.EnvVariableType, EnvironmentScope: "production", Protected: true, Masked: true},
+		{Key: "SIGNING_KEY", Value: "-----BEGIN KEY-----", VariableType: gitlab.FileVariableType, EnvironmentScope: "*", Hidden: true},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mockError     error
+		expected      []ciVariable
+		expectedError string
+	}{
+		{
+			name: "values left out by default",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+			},
+			expected: []ciVariable{
+				{Key: "LOG_LEVEL", VariableType: "env_var", EnvironmentScope: "*"},
+				{Key: "DEPLOY_TOKEN", VariableType: "env_var", EnvironmentScope: "production", Protected: true, Masked: true},
+				{Key: "SIGNING_KEY", VariableType: "file", EnvironmentScope: "*", Hidden: true},
+			},
+		},
+		{
+			name: "values of masked and hidden variables withheld",
+			args: map[string]interface{}{
+				"namespace":      "group",
+				"project":        "project",
+				"include_values": true,
+			},
+			expected: []ciVariable{
+				{Key: "LOG_LEVEL", VariableType: "env_var", EnvironmentScope: "*", Value: "debug"},
+				{Key: "DEPLOY_TOKEN", VariableType: "env_var", EnvironmentScope: "production", Protected: true, Masked: true, ValueWithheld: true},
+				{Key: "SIGNING_KEY", VariableType: "file", EnvironmentScope: "*", Hidden: true, ValueWithheld: true},
+			},
+		},
+		{
+			name: "filtered by environment scope",
+			args: map[string]interface{}{
+				"namespace":         "group",
+				"project":           "project",
+				"environment_scope": "production",
+			},
+			expected: []ciVariable{
+				{Key: "DEPLOY_TOKEN", VariableType: "env_var", EnvironmentScope: "production", Protected: true, Masked: true},
+			},
+		},
+		{
+			name: "group variables",
+			args: map[string]interface{}{
+				"namespace": "group",
+			},
+			expected: []ciVariable{
+				{Key: "REGISTRY", VariableType: "env_var", EnvironmentScope: "*"},
+			},
+		},
+		{
+			name: "environment scope of instance variables",
+			args: map[string]interface{}{
+				"level":             "instance",
+				"environment_scope": "production",
+			},
+			expectedError: "instance variables have no environment scope",
+		},
+		{
+			name: "GitLab API error",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+			},
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to list project variables: API error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					ProjectVariables: &mockProjectVariablesService{
+						listFunc: func(pid interface{}, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							return projectVariables, ok, tc.mockError
+						},
+					},
+					GroupVariables: &mockGroupVariablesService{
+						listFunc: func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+							assert.Equal(t, "group", gid)
+							return []*gitlab.GroupVariable{{Key: "REGISTRY", Value: "registry.example.com", VariableType: gitlab.EnvVariableType, EnvironmentScope: "*"}}, ok, tc.mockError
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := ListCIVariables(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				return
+			}
+			assert.NotContains(t, textContent.Text, "s3cr3t-token")
+			assert.NotContains(t, textContent.Text, "BEGIN KEY")
+
+			var got ciVariableList
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+			assert.Equal(t, tc.expected, got.Variables)
+			assert.False(t, got.Truncated)
+		})
+	}
+}
+
+func TestReadVariableSettings(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expected      variableSettings
+		expectedError string
+	}{
+		{
+			name: "explicit empty value and false flag",
+			args: map[string]interface{}{
+				"value":     "",
+				"protected": false,
+				"raw":       true,
+			},
+			expected: variableSettings{value: gitlab.Ptr(""), protected: gitlab.Ptr(false), raw: gitlab.Ptr(true)},
+		},
+		{
+			name: "file variable",
+			args: map[string]interface{}{
+				"variable_type": "file",
+			},
+			expected: variableSettings{variableType: gitlab.Ptr(gitlab.FileVariableType)},
+		},
+		{
+			name: "masked given as a string",
+			args: map[string]interface{}{
+				"masked": "true",
+			},
+			expectedError: "parameter masked is not of type bool, is string",
+		},
+		{
+			name: "value given as a number",
+			args: map[string]interface{}{
+				"value": float64(42),
+			},
+			expectedError: "parameter value is not of type string, is float64",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			settings, err := readVariableSettings(createMCPRequest(tc.args))
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, settings)
+		})
+	}
+}
+
+func TestCreateCIVariable(t *testing.T) {
+	getClient := func(ctx context.Context) (*gitlab.Client, error) {
+		return &gitlab.Client{
+			ProjectVariables: &mockProjectVariablesService{
+				createFunc: func(pid interface{}, opt *gitlab.CreateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+					assert.Equal(t, "group/project", pid)
+					assert.Equal(t, "s3cr3t-token", *opt.Value)
+					assert.Equal(t, "production", *opt.EnvironmentScope)
+					assert.True(t, *opt.Masked)
+					assert.True(t, *opt.MaskedAndHidden)
+					assert.Nil(t, opt.Protected)
+					return &gitlab.ProjectVariable{
+						Key: *opt.Key, Value: *opt.Value, VariableType: gitlab.EnvVariableType,
+						EnvironmentScope: *opt.EnvironmentScope, Masked: true, Hidden: true,
+					}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusCreated}}, nil
+				},
+			},
+		}, nil
+	}
+
+	translationHelper := func(key string, defaultValue string) string {
+		return defaultValue
+	}
+
+	_, handler := CreateCIVariable(getClient, translationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"namespace":         "group",
+		"project":           "project",
+		"key":               "DEPLOY_TOKEN",
+		"value":             "s3cr3t-token",
+		"environment_scope": "production",
+		"hidden":            true,
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+	assert.NotContains(t, textContent.Text, "s3cr3t-token")
+
+	var got ciVariable
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &got))
+	assert.Equal(t, ciVariable{Key: "DEPLOY_TOKEN", VariableType: "env_var", EnvironmentScope: "production", Masked: true, Hidden: true}, got)
+}
+
+func TestUpdateCIVariable(t *testing.T) {
+	ok := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		masked        bool
+		expectedError string
+	}{
+		{
+			name: "new value",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"key":       "DEPLOY_TOKEN",
+				"value":     "n3w-token",
+			},
+			masked: true,
+		},
+		{
+			name: "unmask with a new value",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"key":       "DEPLOY_TOKEN",
+				"value":     "n3w-token",
+				"masked":    false,
+			},
+			masked: true,
+		},
+		{
+			name: "unmask a variable that is not masked",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"key":       "DEPLOY_TOKEN",
+				"masked":    false,
+			},
+		},
+		{
+			name: "unmask without a new value",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"key":       "DEPLOY_TOKEN",
+				"masked":    false,
+			},
+			masked:        true,
+			expectedError: "variable DEPLOY_TOKEN is masked; give a new value to unmask it",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := false
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					ProjectVariables: &mockProjectVariablesService{
+						getFunc: func(pid interface{}, key string, opt *gitlab.GetProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							return &gitlab.ProjectVariable{Key: key, Value: "s3cr3t-token", Masked: tc.masked}, ok, nil
+						},
+						updateFunc: func(pid interface{}, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+							updated = true
+							masked := tc.masked
+							if opt.Masked != nil {
+								masked = *opt.Masked
+							}
+							return &gitlab.ProjectVariable{Key: key, Value: "n3w-token", VariableType: gitlab.EnvVariableType, Masked: masked}, ok, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := UpdateCIVariable(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				assert.False(t, updated)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+			assert.True(t, updated)
+			assert.NotContains(t, textContent.Text, "token")
+		})
+	}
+}
+
+func TestDeleteCIVariable(t *testing.T) {
+	noContent := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNoContent}}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedLevel string
+		expectedError string
+	}{
+		{
+			name: "project variable",
+			args: map[string]interface{}{
+				"namespace": "group",
+				"project":   "project",
+				"key":       "DEPLOY_TOKEN",
+			},
+			expectedLevel: "project",
+		},
+		{
+			name: "instance variable with explicit level",
+			args: map[string]interface{}{
+				"level": "instance",
+				"key":   "DEPLOY_TOKEN",
+			},
+			expectedLevel: "instance",
+		},
+		{
+			name: "only key never targets the instance",
+			args: map[string]interface{}{
+				"key": "DEPLOY_TOKEN",
+			},
+			expectedError: "namespace is required, or level must be set to instance to change instance variables",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var removed string
+			getClient := func(ctx context.Context) (*gitlab.Client, error) {
+				return &gitlab.Client{
+					ProjectVariables: &mockProjectVariablesService{
+						removeFunc: func(pid interface{}, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+							assert.Equal(t, "group/project", pid)
+							assert.Equal(t, "DEPLOY_TOKEN", key)
+							removed = "project"
+							return noContent, nil
+						},
+					},
+					InstanceVariables: &mockInstanceVariablesService{
+						removeFunc: func(key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+							assert.Equal(t, "DEPLOY_TOKEN", key)
+							removed = "instance"
+							return noContent, nil
+						},
+					},
+				}, nil
+			}
+
+			translationHelper := func(key string, defaultValue string) string {
+				return defaultValue
+			}
+
+			_, handler := DeleteCIVariable(getClient, translationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedError)
+				assert.Empty(t, removed)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+			assert.Equal(t, tc.expectedLevel, removed)
+			assert.Equal(t, "Variable DEPLOY_TOKEN deleted", textContent.Text)
+		})
+	}
+}